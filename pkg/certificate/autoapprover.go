@@ -0,0 +1,114 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var retryInterval = time.Second * 3
+
+// AutoApprover approves in the background the pending kubelet client and serving certificatesigningrequests of the
+// expected nodes, such as the workers joining the cluster during a day-2 installation.
+type AutoApprover struct {
+	apiClient *clients.Settings
+	nodeNames []string
+	mutex     sync.Mutex
+	approved  []string
+	done      chan struct{}
+}
+
+// ApprovePendingForNodes approves once the pending kubelet client and serving certificatesigningrequests of the given
+// nodes and returns the names of the approved certificatesigningrequests.
+func ApprovePendingForNodes(apiClient *clients.Settings, nodeNames ...string) ([]string, error) {
+	glog.V(100).Infof("Approving pending kubelet certificatesigningrequests of nodes %v", nodeNames)
+
+	if len(nodeNames) == 0 {
+		return nil, fmt.Errorf("cannot approve certificatesigningrequests for empty list of nodes")
+	}
+
+	pendingCSRs, err := ListPending(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingCSRs = FilterBySigner(pendingCSRs,
+		certificatesv1.KubeAPIServerClientKubeletSignerName, certificatesv1.KubeletServingSignerName)
+
+	var approvedCSRs []string
+
+	for _, csr := range FilterByNode(pendingCSRs, nodeNames...) {
+		_, err := csr.Approve()
+		if err != nil {
+			return approvedCSRs, err
+		}
+
+		approvedCSRs = append(approvedCSRs, csr.Definition.Name)
+	}
+
+	return approvedCSRs, nil
+}
+
+// StartAutoApprover starts approving in the background the pending kubelet client and serving
+// certificatesigningrequests of the given nodes until the context is cancelled. Requests of any other node are left
+// untouched.
+func StartAutoApprover(ctx context.Context, apiClient *clients.Settings, nodeNames ...string) (*AutoApprover, error) {
+	glog.V(100).Infof("Starting certificatesigningrequest auto approver for nodes %v", nodeNames)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("certificatesigningrequest auto approver 'apiClient' cannot be empty")
+	}
+
+	if len(nodeNames) == 0 {
+		glog.V(100).Infof("The nodeNames of the auto approver are empty")
+
+		return nil, fmt.Errorf("certificatesigningrequest auto approver 'nodeNames' cannot be empty")
+	}
+
+	approver := &AutoApprover{
+		apiClient: apiClient,
+		nodeNames: nodeNames,
+		done:      make(chan struct{}),
+	}
+
+	go func() {
+		defer close(approver.done)
+
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			approvedCSRs, err := ApprovePendingForNodes(approver.apiClient, approver.nodeNames...)
+			if err != nil {
+				glog.V(100).Infof("Failed to approve certificatesigningrequests, retrying: %v", err)
+			}
+
+			approver.mutex.Lock()
+			defer approver.mutex.Unlock()
+
+			approver.approved = append(approver.approved, approvedCSRs...)
+		}, retryInterval)
+
+		glog.V(100).Infof("Stopped certificatesigningrequest auto approver for nodes %v", approver.nodeNames)
+	}()
+
+	return approver, nil
+}
+
+// Approved returns the names of the certificatesigningrequests approved so far.
+func (approver *AutoApprover) Approved() []string {
+	approver.mutex.Lock()
+	defer approver.mutex.Unlock()
+
+	return append([]string{}, approver.approved...)
+}
+
+// Done returns a channel closed once the auto approver stopped after the context cancellation.
+func (approver *AutoApprover) Done() <-chan struct{} {
+	return approver.done
+}
@@ -0,0 +1,151 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCertificateApprovePendingForNodes(t *testing.T) {
+	testCases := []struct {
+		nodeNames     []string
+		approveTwice  bool
+		expectedNames []string
+		expectedError error
+	}{
+		{
+			nodeNames:     []string{"worker-3"},
+			expectedNames: []string{"csr-client", "csr-serving"},
+			expectedError: nil,
+		},
+		{
+			nodeNames:     []string{"worker-3"},
+			approveTwice:  true,
+			expectedNames: nil,
+			expectedError: nil,
+		},
+		{
+			nodeNames:     []string{"worker-3", "worker-4"},
+			expectedNames: []string{"csr-client", "csr-serving", "csr-other-node"},
+			expectedError: nil,
+		},
+		{
+			nodeNames:     []string{"worker-6"},
+			expectedNames: nil,
+			expectedError: nil,
+		},
+		{
+			nodeNames:     nil,
+			expectedError: fmt.Errorf("cannot approve certificatesigningrequests for empty list of nodes"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildAutoApproverClientWithDummyObjects()
+
+		if testCase.approveTwice {
+			_, err := ApprovePendingForNodes(testSettings, testCase.nodeNames...)
+			assert.Nil(t, err)
+		}
+
+		approved, err := ApprovePendingForNodes(testSettings, testCase.nodeNames...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.ElementsMatch(t, testCase.expectedNames, approved)
+		}
+	}
+}
+
+func TestCertificateStartAutoApprover(t *testing.T) {
+	testCases := []struct {
+		nodeNames       []string
+		client          bool
+		expectedNames   []string
+		expectedPending []string
+		expectedError   error
+	}{
+		{
+			nodeNames:       []string{"worker-3"},
+			client:          true,
+			expectedNames:   []string{"csr-client", "csr-serving"},
+			expectedPending: []string{"csr-other-node", "csr-spoofed", "csr-admin"},
+			expectedError:   nil,
+		},
+		{
+			nodeNames:     nil,
+			client:        true,
+			expectedError: fmt.Errorf("certificatesigningrequest auto approver 'nodeNames' cannot be empty"),
+		},
+		{
+			nodeNames:     []string{"worker-3"},
+			client:        false,
+			expectedError: fmt.Errorf("certificatesigningrequest auto approver 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildAutoApproverClientWithDummyObjects()
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+
+		approver, err := StartAutoApprover(ctx, testSettings, testCase.nodeNames...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			cancel()
+
+			continue
+		}
+
+		assert.Eventually(t, func() bool {
+			return len(approver.Approved()) == len(testCase.expectedNames)
+		}, time.Second, 10*time.Millisecond)
+
+		cancel()
+
+		select {
+		case <-approver.Done():
+		case <-time.After(time.Second):
+			t.Fatal("auto approver did not stop after context cancellation")
+		}
+
+		assert.ElementsMatch(t, testCase.expectedNames, approver.Approved())
+
+		for _, name := range testCase.expectedPending {
+			testBuilder, err := Pull(testSettings, name)
+			assert.Nil(t, err)
+			assert.True(t, testBuilder.IsPending())
+		}
+	}
+}
+
+func buildAutoApproverClientWithDummyObjects() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyAutoApproverCSRList(),
+	})
+}
+
+func buildDummyAutoApproverCSRList() []runtime.Object {
+	return append([]runtime.Object{},
+		buildDummyCSRWithSigner(
+			"csr-client", certificatesv1.KubeAPIServerClientKubeletSignerName, nodeBootstrapperUser, "worker-3"),
+		buildDummyCSRWithSigner(
+			"csr-serving", certificatesv1.KubeletServingSignerName, "system:node:worker-3", "worker-3"),
+		buildDummyCSRWithSigner(
+			"csr-other-node", certificatesv1.KubeAPIServerClientKubeletSignerName, nodeBootstrapperUser, "worker-4"),
+		buildDummyCSRWithSigner(
+			"csr-spoofed", certificatesv1.KubeletServingSignerName, "system:node:worker-4", "worker-3"),
+		buildDummyCSRWithSigner(
+			"csr-admin", certificatesv1.KubeAPIServerClientSignerName, nodeBootstrapperUser, "worker-3"))
+}
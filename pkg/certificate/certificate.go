@@ -0,0 +1,344 @@
+package certificate
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// nodeUserPrefix is the prefix of the user name and certificate common name of kubelets.
+	nodeUserPrefix = "system:node:"
+	// nodeGroup is the group of kubelets, which must be the only organization of their certificates.
+	nodeGroup = "system:nodes"
+	// nodeBootstrapperUser is the service account requesting the first client certificate of joining kubelets.
+	nodeBootstrapperUser = "system:serviceaccount:openshift-machine-config-operator:node-bootstrapper"
+	// approvalReason is the reason set on the conditions of CSRs approved or denied by the builder.
+	approvalReason = "EcoGoinfraApproval"
+)
+
+// Builder provides struct for the certificatesigningrequest object containing connection to the cluster and the
+// certificatesigningrequest definitions.
+type Builder struct {
+	// CertificateSigningRequest definition. Used to store the certificatesigningrequest object.
+	Definition *certificatesv1.CertificateSigningRequest
+	// Created certificatesigningrequest object.
+	Object *certificatesv1.CertificateSigningRequest
+	// Used in functions that define or mutate the certificatesigningrequest definition. errorMsg is processed before
+	// the certificatesigningrequest object is updated.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// Pull loads an existing certificatesigningrequest into the Builder struct.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing certificatesigningrequest name: %s", name)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("certificatesigningrequest 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("certificatesigningrequest 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("certificatesigningrequest object %s does not exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// Exists checks whether the given certificatesigningrequest exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if certificatesigningrequest %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.CertificatesV1().CertificateSigningRequests().Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the certificatesigningrequest.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting certificatesigningrequest %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.CertificatesV1().CertificateSigningRequests().Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete certificatesigningrequest: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Approve approves the certificatesigningrequest, the equivalent of oc adm certificate approve. Approving an already
+// approved certificatesigningrequest is a no-op.
+func (builder *Builder) Approve() (*Builder, error) {
+	return builder.setApproval(certificatesv1.CertificateApproved, "approved by eco-goinfra")
+}
+
+// Deny denies the certificatesigningrequest, the equivalent of oc adm certificate deny. Denying an already denied
+// certificatesigningrequest is a no-op.
+func (builder *Builder) Deny() (*Builder, error) {
+	return builder.setApproval(certificatesv1.CertificateDenied, "denied by eco-goinfra")
+}
+
+// IsApproved checks whether the certificatesigningrequest was approved.
+func (builder *Builder) IsApproved() bool {
+	return builder.hasCondition(certificatesv1.CertificateApproved)
+}
+
+// IsDenied checks whether the certificatesigningrequest was denied.
+func (builder *Builder) IsDenied() bool {
+	return builder.hasCondition(certificatesv1.CertificateDenied)
+}
+
+// IsPending checks whether the certificatesigningrequest is neither approved, denied nor failed.
+func (builder *Builder) IsPending() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	return !builder.hasCondition(certificatesv1.CertificateApproved) &&
+		!builder.hasCondition(certificatesv1.CertificateDenied) &&
+		!builder.hasCondition(certificatesv1.CertificateFailed)
+}
+
+// GetRequestingNode returns the name of the node the certificatesigningrequest was created for, read from the
+// system:node:<name> common name of the request. Like the kube-controller-manager and the cluster machine approver,
+// only kubelet client and serving requests of the system:nodes organization are related to a node, and only when
+// their usages and subject alternative names match their signer. Client requests must be made by the node
+// bootstrapper or the node itself, serving requests by the node itself. An empty string is returned if the
+// certificatesigningrequest is not related to a node.
+func (builder *Builder) GetRequestingNode() string {
+	if valid, _ := builder.validate(); !valid {
+		return ""
+	}
+
+	request, err := parseCertificateRequest(builder.Definition.Spec.Request)
+	if err != nil {
+		glog.V(100).Infof("Failed to parse request of certificatesigningrequest %s: %v", builder.Definition.Name, err)
+
+		return ""
+	}
+
+	nodeName, found := strings.CutPrefix(request.Subject.CommonName, nodeUserPrefix)
+	if !found || nodeName == "" || !slices.Equal(request.Subject.Organization, []string{nodeGroup}) {
+		return ""
+	}
+
+	switch builder.Definition.Spec.SignerName {
+	case certificatesv1.KubeAPIServerClientKubeletSignerName:
+		err = validateKubeletClientRequest(builder.Definition.Spec, request)
+	case certificatesv1.KubeletServingSignerName:
+		err = validateKubeletServingRequest(builder.Definition.Spec, request)
+	default:
+		return ""
+	}
+
+	if err != nil {
+		glog.V(100).Infof("Certificatesigningrequest %s is not a valid request of node %s: %v",
+			builder.Definition.Name, nodeName, err)
+
+		return ""
+	}
+
+	return nodeName
+}
+
+// setApproval adds the given approval condition to the certificatesigningrequest through the approval subresource.
+func (builder *Builder) setApproval(
+	conditionType certificatesv1.RequestConditionType, message string) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Setting condition %s on certificatesigningrequest %s", conditionType, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("certificatesigningrequest object %s does not exist", builder.Definition.Name)
+	}
+
+	builder.Definition = builder.Object
+
+	if builder.hasCondition(conditionType) {
+		return builder, nil
+	}
+
+	builder.Definition.Status.Conditions = append(builder.Definition.Status.Conditions,
+		certificatesv1.CertificateSigningRequestCondition{
+			Type:           conditionType,
+			Status:         corev1.ConditionTrue,
+			Reason:         approvalReason,
+			Message:        message,
+			LastUpdateTime: metav1.Now(),
+		})
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.CertificatesV1().CertificateSigningRequests().UpdateApproval(
+		context.TODO(), builder.Definition.Name, builder.Definition, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("failed to set condition %s on certificatesigningrequest %s: %w",
+			conditionType, builder.Definition.Name, err)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// hasCondition checks whether the certificatesigningrequest has the given condition set to True.
+func (builder *Builder) hasCondition(conditionType certificatesv1.RequestConditionType) bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	for _, condition := range builder.Definition.Status.Conditions {
+		if condition.Type == conditionType && condition.Status != corev1.ConditionFalse {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "CertificateSigningRequest"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
+
+// parseCertificateRequest decodes the PEM encoded PKCS#10 request of a certificatesigningrequest.
+func parseCertificateRequest(request []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("request is not a PEM encoded certificate request")
+	}
+
+	return x509.ParseCertificateRequest(block.Bytes)
+}
+
+// validateKubeletClientRequest checks that a kubelet client request was made by the node bootstrapper or by the node
+// of its common name, has the client usages and no subject alternative name.
+func validateKubeletClientRequest(
+	spec certificatesv1.CertificateSigningRequestSpec, request *x509.CertificateRequest) error {
+	if spec.Username != nodeBootstrapperUser && spec.Username != request.Subject.CommonName {
+		return fmt.Errorf("username %s is neither the node bootstrapper nor %s", spec.Username, request.Subject.CommonName)
+	}
+
+	if !hasKubeletUsages(spec.Usages, certificatesv1.UsageClientAuth) {
+		return fmt.Errorf("usages %v are not valid for a kubelet client certificate", spec.Usages)
+	}
+
+	if len(request.DNSNames) > 0 || len(request.IPAddresses) > 0 ||
+		len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return fmt.Errorf("kubelet client certificate request cannot have subject alternative names")
+	}
+
+	return nil
+}
+
+// validateKubeletServingRequest checks that a kubelet serving request was made by the node of its common name, has the
+// serving usages and only DNS and IP subject alternative names, at least one of them.
+func validateKubeletServingRequest(
+	spec certificatesv1.CertificateSigningRequestSpec, request *x509.CertificateRequest) error {
+	if spec.Username != request.Subject.CommonName {
+		return fmt.Errorf("username %s is not %s", spec.Username, request.Subject.CommonName)
+	}
+
+	if !hasKubeletUsages(spec.Usages, certificatesv1.UsageServerAuth) {
+		return fmt.Errorf("usages %v are not valid for a kubelet serving certificate", spec.Usages)
+	}
+
+	if len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return fmt.Errorf("kubelet serving certificate request cannot have email or URI subject alternative names")
+	}
+
+	if len(request.DNSNames) == 0 && len(request.IPAddresses) == 0 {
+		return fmt.Errorf("kubelet serving certificate request requires a DNS or IP subject alternative name")
+	}
+
+	return nil
+}
+
+// hasKubeletUsages checks that the usages contain digital signature and the given auth usage, and optionally key
+// encipherment, but nothing else.
+func hasKubeletUsages(usages []certificatesv1.KeyUsage, authUsage certificatesv1.KeyUsage) bool {
+	allowedUsages := []certificatesv1.KeyUsage{
+		certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, authUsage}
+
+	for _, usage := range usages {
+		if !slices.Contains(allowedUsages, usage) {
+			return false
+		}
+	}
+
+	return slices.Contains(usages, certificatesv1.UsageDigitalSignature) && slices.Contains(usages, authUsage)
+}
@@ -0,0 +1,553 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultCSRName     = "csr-test"
+	defaultCSRNodeName = "worker-3"
+)
+
+func TestCertificatePull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultCSRName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("certificatesigningrequest 'name' cannot be empty"),
+		},
+		{
+			name:                defaultCSRName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("certificatesigningrequest object csr-test does not exist"),
+		},
+		{
+			name:                defaultCSRName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("certificatesigningrequest 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyCSR()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestCertificateExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidCSRBuilder(buildCSRClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidCSRBuilder(buildCSRClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidCSRBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestCertificateDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidCSRBuilder(buildCSRClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidCSRBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidCSRBuilder(buildCSRClientWithDummyObject()),
+			expectedError: fmt.Errorf("certificatesigningrequest 'name' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestCertificateApprove(t *testing.T) {
+	testCases := []struct {
+		conditions          []certificatesv1.RequestConditionType
+		addToRuntimeObjects bool
+		expectedConditions  int
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedConditions:  1,
+			expectedError:       nil,
+		},
+		{
+			conditions:          []certificatesv1.RequestConditionType{certificatesv1.CertificateApproved},
+			addToRuntimeObjects: true,
+			expectedConditions:  1,
+			expectedError:       nil,
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("certificatesigningrequest object csr-test does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyCSRWithConditions(testCase.conditions...))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		})
+
+		testBuilder, err := buildValidCSRBuilder(testSettings).Approve()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.True(t, testBuilder.IsApproved())
+			assert.False(t, testBuilder.IsPending())
+			assert.Len(t, testBuilder.Object.Status.Conditions, testCase.expectedConditions)
+
+			pulledBuilder, err := Pull(testSettings, defaultCSRName)
+			assert.Nil(t, err)
+			assert.True(t, pulledBuilder.IsApproved())
+		}
+	}
+}
+
+func TestCertificateDeny(t *testing.T) {
+	testCases := []struct {
+		conditions          []certificatesv1.RequestConditionType
+		addToRuntimeObjects bool
+		expectedConditions  int
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedConditions:  1,
+			expectedError:       nil,
+		},
+		{
+			conditions:          []certificatesv1.RequestConditionType{certificatesv1.CertificateDenied},
+			addToRuntimeObjects: true,
+			expectedConditions:  1,
+			expectedError:       nil,
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("certificatesigningrequest object csr-test does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyCSRWithConditions(testCase.conditions...))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		})
+
+		testBuilder, err := buildValidCSRBuilder(testSettings).Deny()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.True(t, testBuilder.IsDenied())
+			assert.False(t, testBuilder.IsApproved())
+			assert.False(t, testBuilder.IsPending())
+			assert.Len(t, testBuilder.Object.Status.Conditions, testCase.expectedConditions)
+		}
+	}
+}
+
+func TestCertificateConditions(t *testing.T) {
+	testCases := []struct {
+		conditions       []certificatesv1.RequestConditionType
+		expectedPending  bool
+		expectedApproved bool
+		expectedDenied   bool
+	}{
+		{
+			conditions:       nil,
+			expectedPending:  true,
+			expectedApproved: false,
+			expectedDenied:   false,
+		},
+		{
+			conditions:       []certificatesv1.RequestConditionType{certificatesv1.CertificateApproved},
+			expectedPending:  false,
+			expectedApproved: true,
+			expectedDenied:   false,
+		},
+		{
+			conditions:       []certificatesv1.RequestConditionType{certificatesv1.CertificateDenied},
+			expectedPending:  false,
+			expectedApproved: false,
+			expectedDenied:   true,
+		},
+		{
+			conditions:       []certificatesv1.RequestConditionType{certificatesv1.CertificateFailed},
+			expectedPending:  false,
+			expectedApproved: false,
+			expectedDenied:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCSRBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder.Definition = buildDummyCSRWithConditions(testCase.conditions...)
+
+		assert.Equal(t, testCase.expectedPending, testBuilder.IsPending())
+		assert.Equal(t, testCase.expectedApproved, testBuilder.IsApproved())
+		assert.Equal(t, testCase.expectedDenied, testBuilder.IsDenied())
+	}
+}
+
+func TestCertificateGetRequestingNode(t *testing.T) {
+	testCases := []struct {
+		signerName   string
+		username     string
+		nodeName     string
+		usages       []certificatesv1.KeyUsage
+		request      []byte
+		expectedNode string
+	}{
+		{
+			signerName:   certificatesv1.KubeAPIServerClientKubeletSignerName,
+			username:     nodeBootstrapperUser,
+			nodeName:     defaultCSRNodeName,
+			expectedNode: defaultCSRNodeName,
+		},
+		{
+			signerName:   certificatesv1.KubeAPIServerClientKubeletSignerName,
+			username:     "system:node:" + defaultCSRNodeName,
+			nodeName:     defaultCSRNodeName,
+			expectedNode: defaultCSRNodeName,
+		},
+		{
+			signerName:   certificatesv1.KubeletServingSignerName,
+			username:     "system:node:" + defaultCSRNodeName,
+			nodeName:     defaultCSRNodeName,
+			expectedNode: defaultCSRNodeName,
+		},
+		{
+			signerName:   certificatesv1.KubeAPIServerClientSignerName,
+			username:     "kube:admin",
+			nodeName:     "",
+			expectedNode: "",
+		},
+		{
+			signerName:   certificatesv1.KubeAPIServerClientKubeletSignerName,
+			username:     "system:node:worker-4",
+			nodeName:     defaultCSRNodeName,
+			expectedNode: "",
+		},
+		{
+			signerName:   certificatesv1.KubeletServingSignerName,
+			username:     nodeBootstrapperUser,
+			nodeName:     defaultCSRNodeName,
+			expectedNode: "",
+		},
+		{
+			signerName:   certificatesv1.KubeletServingSignerName,
+			username:     "system:node:worker-4",
+			nodeName:     defaultCSRNodeName,
+			expectedNode: "",
+		},
+		{
+			signerName:   certificatesv1.KubeAPIServerClientKubeletSignerName,
+			username:     nodeBootstrapperUser,
+			nodeName:     defaultCSRNodeName,
+			usages:       []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			expectedNode: "",
+		},
+		{
+			signerName:   certificatesv1.KubeletServingSignerName,
+			username:     "system:node:" + defaultCSRNodeName,
+			nodeName:     defaultCSRNodeName,
+			usages:       []certificatesv1.KeyUsage{certificatesv1.UsageServerAuth},
+			expectedNode: "",
+		},
+		{
+			signerName:   certificatesv1.KubeletServingSignerName,
+			username:     "system:node:" + defaultCSRNodeName,
+			nodeName:     defaultCSRNodeName,
+			usages:       []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth},
+			expectedNode: "",
+		},
+		{
+			signerName: certificatesv1.KubeAPIServerClientKubeletSignerName,
+			username:   nodeBootstrapperUser,
+			nodeName:   defaultCSRNodeName,
+			request: buildDummyCertificateRequest(x509.CertificateRequest{Subject: pkix.Name{
+				CommonName: "system:node:" + defaultCSRNodeName, Organization: []string{"system:masters"}}}),
+			expectedNode: "",
+		},
+		{
+			signerName: certificatesv1.KubeAPIServerClientKubeletSignerName,
+			username:   nodeBootstrapperUser,
+			nodeName:   defaultCSRNodeName,
+			request: buildDummyCertificateRequest(x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "system:node:" + defaultCSRNodeName, Organization: []string{nodeGroup}},
+				DNSNames: []string{defaultCSRNodeName}}),
+			expectedNode: "",
+		},
+		{
+			signerName: certificatesv1.KubeletServingSignerName,
+			username:   "system:node:" + defaultCSRNodeName,
+			nodeName:   defaultCSRNodeName,
+			request: buildDummyCertificateRequest(x509.CertificateRequest{
+				Subject:        pkix.Name{CommonName: "system:node:" + defaultCSRNodeName, Organization: []string{nodeGroup}},
+				DNSNames:       []string{defaultCSRNodeName},
+				EmailAddresses: []string{"admin@example.com"}}),
+			expectedNode: "",
+		},
+		{
+			signerName: certificatesv1.KubeletServingSignerName,
+			username:   "system:node:" + defaultCSRNodeName,
+			nodeName:   defaultCSRNodeName,
+			request: buildDummyCertificateRequest(x509.CertificateRequest{
+				Subject: pkix.Name{CommonName: "system:node:" + defaultCSRNodeName, Organization: []string{nodeGroup}}}),
+			expectedNode: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCSRBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder.Definition = buildDummyCSRWithSigner(
+			defaultCSRName, testCase.signerName, testCase.username, testCase.nodeName)
+
+		if testCase.usages != nil {
+			testBuilder.Definition.Spec.Usages = testCase.usages
+		}
+
+		if testCase.request != nil {
+			testBuilder.Definition.Spec.Request = testCase.request
+		}
+
+		assert.Equal(t, testCase.expectedNode, testBuilder.GetRequestingNode())
+	}
+}
+
+func TestCertificateValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil CertificateSigningRequest builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined CertificateSigningRequest",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "CertificateSigningRequest builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCSRBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidCSRBuilder(apiClient *clients.Settings) *Builder {
+	return &Builder{
+		apiClient: apiClient,
+		Definition: &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: defaultCSRName,
+			},
+		},
+	}
+}
+
+func buildInvalidCSRBuilder(apiClient *clients.Settings) *Builder {
+	builder := buildValidCSRBuilder(apiClient)
+	builder.Definition.Name = ""
+	builder.errorMsg = "certificatesigningrequest 'name' cannot be empty"
+
+	return builder
+}
+
+func buildCSRClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyCSR(),
+	})
+}
+
+func buildDummyCSR() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyCSRWithConditions())
+}
+
+// buildDummyCSRWithConditions returns the default kubelet client certificatesigningrequest with the given conditions
+// set to True.
+func buildDummyCSRWithConditions(
+	conditionTypes ...certificatesv1.RequestConditionType) *certificatesv1.CertificateSigningRequest {
+	csr := buildDummyCSRWithSigner(
+		defaultCSRName, certificatesv1.KubeAPIServerClientKubeletSignerName, nodeBootstrapperUser, defaultCSRNodeName)
+
+	for _, conditionType := range conditionTypes {
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+			Type:   conditionType,
+			Status: corev1.ConditionTrue,
+		})
+	}
+
+	return csr
+}
+
+// buildDummyCSRWithSigner returns a pending certificatesigningrequest whose request common name is
+// system:node:<nodeName>, unless nodeName is empty. Kubelet serving requests get the serving usages and the node name
+// as DNS name, any other request the client usages.
+func buildDummyCSRWithSigner(name, signerName, username, nodeName string) *certificatesv1.CertificateSigningRequest {
+	template := x509.CertificateRequest{Subject: pkix.Name{CommonName: "kube:admin"}}
+	if nodeName != "" {
+		template.Subject = pkix.Name{CommonName: nodeUserPrefix + nodeName, Organization: []string{nodeGroup}}
+	}
+
+	usages := []certificatesv1.KeyUsage{
+		certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageClientAuth}
+
+	if signerName == certificatesv1.KubeletServingSignerName {
+		template.DNSNames = []string{nodeName}
+		usages = []certificatesv1.KeyUsage{
+			certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth}
+	}
+
+	return &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    buildDummyCertificateRequest(template),
+			SignerName: signerName,
+			Username:   username,
+			Usages:     usages,
+		},
+	}
+}
+
+func buildDummyCertificateRequest(template x509.CertificateRequest) []byte {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	request, err := x509.CreateCertificateRequest(rand.Reader, &template, privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: request})
+}
@@ -0,0 +1,104 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns certificatesigningrequest inventory.
+func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("certificatesigningrequest 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list certificatesigningrequests, 'apiClient' parameter is empty")
+	}
+
+	logMessage := "Listing certificatesigningrequests"
+	passedOptions := metav1.ListOptions{}
+
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	glog.V(100).Infof(logMessage)
+
+	csrList, err := apiClient.K8sClient.CertificatesV1().CertificateSigningRequests().List(
+		context.TODO(), passedOptions)
+	if err != nil {
+		glog.V(100).Infof("Failed to list certificatesigningrequests due to %s", err.Error())
+
+		return nil, err
+	}
+
+	var csrObjects []*Builder
+
+	for _, csr := range csrList.Items {
+		copiedCSR := csr
+		csrBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedCSR,
+			Definition: &copiedCSR,
+		}
+
+		csrObjects = append(csrObjects, csrBuilder)
+	}
+
+	return csrObjects, nil
+}
+
+// ListPending returns the certificatesigningrequests which are neither approved, denied nor failed.
+func ListPending(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	csrList, err := List(apiClient, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCSRs []*Builder
+
+	for _, csr := range csrList {
+		if csr.IsPending() {
+			pendingCSRs = append(pendingCSRs, csr)
+		}
+	}
+
+	return pendingCSRs, nil
+}
+
+// FilterBySigner returns the certificatesigningrequests of the list requesting a certificate from one of the given
+// signers, such as kubernetes.io/kube-apiserver-client-kubelet or kubernetes.io/kubelet-serving.
+func FilterBySigner(csrList []*Builder, signerNames ...string) []*Builder {
+	var filteredCSRs []*Builder
+
+	for _, csr := range csrList {
+		if valid, _ := csr.validate(); valid && slices.Contains(signerNames, csr.Definition.Spec.SignerName) {
+			filteredCSRs = append(filteredCSRs, csr)
+		}
+	}
+
+	return filteredCSRs
+}
+
+// FilterByNode returns the certificatesigningrequests of the list created for one of the given nodes.
+func FilterByNode(csrList []*Builder, nodeNames ...string) []*Builder {
+	var filteredCSRs []*Builder
+
+	for _, csr := range csrList {
+		if nodeName := csr.GetRequestingNode(); nodeName != "" && slices.Contains(nodeNames, nodeName) {
+			filteredCSRs = append(filteredCSRs, csr)
+		}
+	}
+
+	return filteredCSRs
+}
@@ -0,0 +1,186 @@
+package certificate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCertificateList(t *testing.T) {
+	testCases := []struct {
+		listOptions   []metav1.ListOptions
+		client        bool
+		expectedCount int
+		expectedError error
+	}{
+		{
+			client:        true,
+			expectedCount: 3,
+			expectedError: nil,
+		},
+		{
+			listOptions:   []metav1.ListOptions{{LabelSelector: "test"}},
+			client:        true,
+			expectedCount: 0,
+			expectedError: nil,
+		},
+		{
+			listOptions:   []metav1.ListOptions{{}, {}},
+			client:        true,
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+		},
+		{
+			client:        false,
+			expectedError: fmt.Errorf("failed to list certificatesigningrequests, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildCSRListClientWithDummyObjects()
+		}
+
+		builders, err := List(testSettings, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, builders, testCase.expectedCount)
+		}
+	}
+}
+
+func TestCertificateListPending(t *testing.T) {
+	testCases := []struct {
+		approved      []string
+		client        bool
+		expectedNames []string
+		expectedError error
+	}{
+		{
+			client:        true,
+			expectedNames: []string{"csr-approved", "csr-client", "csr-serving"},
+			expectedError: nil,
+		},
+		{
+			approved:      []string{"csr-approved"},
+			client:        true,
+			expectedNames: []string{"csr-client", "csr-serving"},
+			expectedError: nil,
+		},
+		{
+			client:        false,
+			expectedError: fmt.Errorf("failed to list certificatesigningrequests, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildCSRListClientWithDummyObjects()
+		}
+
+		for _, name := range testCase.approved {
+			testBuilder, err := Pull(testSettings, name)
+			assert.Nil(t, err)
+
+			_, err = testBuilder.Approve()
+			assert.Nil(t, err)
+		}
+
+		builders, err := ListPending(testSettings)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.ElementsMatch(t, testCase.expectedNames, getCSRNames(builders))
+		}
+	}
+}
+
+func TestCertificateFilterBySigner(t *testing.T) {
+	testCases := []struct {
+		signerNames   []string
+		expectedNames []string
+	}{
+		{
+			signerNames:   []string{certificatesv1.KubeletServingSignerName},
+			expectedNames: []string{"csr-serving"},
+		},
+		{
+			signerNames:   []string{certificatesv1.KubeAPIServerClientKubeletSignerName},
+			expectedNames: []string{"csr-approved", "csr-client"},
+		},
+		{
+			signerNames:   []string{certificatesv1.KubeAPIServerClientSignerName},
+			expectedNames: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		builders, err := List(buildCSRListClientWithDummyObjects())
+		assert.Nil(t, err)
+
+		assert.ElementsMatch(t, testCase.expectedNames,
+			getCSRNames(FilterBySigner(builders, testCase.signerNames...)))
+	}
+}
+
+func TestCertificateFilterByNode(t *testing.T) {
+	testCases := []struct {
+		nodeNames     []string
+		expectedNames []string
+	}{
+		{
+			nodeNames:     []string{"worker-3"},
+			expectedNames: []string{"csr-client"},
+		},
+		{
+			nodeNames:     []string{"worker-4", "worker-5"},
+			expectedNames: []string{"csr-approved", "csr-serving"},
+		},
+		{
+			nodeNames:     []string{"worker-6"},
+			expectedNames: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		builders, err := List(buildCSRListClientWithDummyObjects())
+		assert.Nil(t, err)
+
+		assert.ElementsMatch(t, testCase.expectedNames, getCSRNames(FilterByNode(builders, testCase.nodeNames...)))
+	}
+}
+
+func buildCSRListClientWithDummyObjects() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyCSRList(),
+	})
+}
+
+func buildDummyCSRList() []runtime.Object {
+	return append([]runtime.Object{},
+		buildDummyCSRWithSigner(
+			"csr-client", certificatesv1.KubeAPIServerClientKubeletSignerName, nodeBootstrapperUser, "worker-3"),
+		buildDummyCSRWithSigner(
+			"csr-serving", certificatesv1.KubeletServingSignerName, "system:node:worker-5", "worker-5"),
+		buildDummyCSRWithSigner(
+			"csr-approved", certificatesv1.KubeAPIServerClientKubeletSignerName, nodeBootstrapperUser, "worker-4"))
+}
+
+func getCSRNames(builders []*Builder) []string {
+	var names []string
+
+	for _, builder := range builders {
+		names = append(names, builder.Definition.Name)
+	}
+
+	return names
+}
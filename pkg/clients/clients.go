@@ -87,10 +87,16 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	scalingv1 "k8s.io/api/autoscaling/v1"
+	scalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	netv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8sFakeClient "k8s.io/client-go/kubernetes/fake"
 	fakeRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -494,6 +500,8 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.Service:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *discoveryv1.EndpointSlice:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.Node:
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.Secret:
@@ -506,6 +514,12 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.ResourceQuota:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *corev1.LimitRange:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *schedulingv1.PriorityClass:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *nodev1.RuntimeClass:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.PersistentVolume:
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.PersistentVolumeClaim:
@@ -514,6 +528,8 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *scalingv1.HorizontalPodAutoscaler:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *scalingv2.HorizontalPodAutoscaler:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *storagev1.StorageClass:
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.ConfigMap:
@@ -522,8 +538,16 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *netv1.NetworkPolicy:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *netv1.Ingress:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *appsv1.DaemonSet:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *batchv1.Job:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *batchv1.CronJob:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *certificatesv1.CertificateSigningRequest:
+			k8sClientObjects = append(k8sClientObjects, v)
 		// Generic Client Objects
 		case *bmhv1alpha1.BareMetalHost:
 			genericClientObjects = append(genericClientObjects, v)
@@ -647,6 +671,8 @@ func GetTestClients(tcp TestClientParams) *Settings {
 		// MCO Client Objects
 		case *mcv1.MachineConfig:
 			mcoObjects = append(mcoObjects, v)
+		case *mcv1.MachineConfigPool:
+			mcoObjects = append(mcoObjects, v)
 		}
 	}
 
@@ -0,0 +1,397 @@
+package clusteroperator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxEventsPerNamespace bounds the number of warning events reported for each related namespace.
+	maxEventsPerNamespace = 10
+	// operatorOperandName is the name of the version entry reporting the version of the clusterOperator itself.
+	operatorOperandName = "operator"
+)
+
+// ConditionReport is the state of a single clusterOperator condition.
+type ConditionReport struct {
+	Status             configv1.ConditionStatus `json:"status"`
+	Reason             string                   `json:"reason,omitempty"`
+	Message            string                   `json:"message,omitempty"`
+	LastTransitionTime time.Time                `json:"lastTransitionTime"`
+}
+
+// RelatedObjectReport lists the problems found on an object related to a degraded clusterOperator.
+type RelatedObjectReport struct {
+	Group     string   `json:"group,omitempty"`
+	Resource  string   `json:"resource"`
+	Namespace string   `json:"namespace,omitempty"`
+	Name      string   `json:"name"`
+	Problems  []string `json:"problems,omitempty"`
+}
+
+// OperatorReport is the health of a single clusterOperator.
+type OperatorReport struct {
+	Name           string                                                   `json:"name"`
+	Versions       map[string]string                                        `json:"versions,omitempty"`
+	Conditions     map[configv1.ClusterStatusConditionType]*ConditionReport `json:"conditions"`
+	RelatedObjects []RelatedObjectReport                                    `json:"relatedObjects,omitempty"`
+}
+
+// IsHealthy checks whether the clusterOperator is Available and neither Degraded nor missing conditions.
+func (report *OperatorReport) IsHealthy() bool {
+	available := report.Conditions[configv1.OperatorAvailable]
+	degraded := report.Conditions[configv1.OperatorDegraded]
+
+	return available != nil && available.Status == configv1.ConditionTrue &&
+		(degraded == nil || degraded.Status != configv1.ConditionTrue)
+}
+
+// HealthReport is the health of all the clusterOperators of the cluster.
+type HealthReport struct {
+	GeneratedAt time.Time        `json:"generatedAt"`
+	Operators   []OperatorReport `json:"operators"`
+}
+
+// GetHealthReport returns the conditions and versions of every clusterOperator. The related objects of the degraded
+// or unavailable clusterOperators are inspected to find failing pods, deployments, daemonsets and warning events.
+func GetHealthReport(apiClient *clients.Settings) (*HealthReport, error) {
+	glog.V(100).Infof("Building clusterOperators health report")
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("clusterOperator 'apiClient' cannot be empty")
+	}
+
+	operatorList := &configv1.ClusterOperatorList{}
+
+	err := apiClient.Client.List(context.TODO(), operatorList)
+	if err != nil {
+		glog.V(100).Infof("Failed to list clusterOperators due to %s", err.Error())
+
+		return nil, err
+	}
+
+	report := &HealthReport{GeneratedAt: time.Now()}
+
+	for index := range operatorList.Items {
+		operatorReport := newOperatorReport(&operatorList.Items[index])
+
+		if !operatorReport.IsHealthy() {
+			operatorReport.RelatedObjects = inspectRelatedObjects(apiClient, operatorList.Items[index].Status.RelatedObjects)
+		}
+
+		report.Operators = append(report.Operators, operatorReport)
+	}
+
+	sort.Slice(report.Operators, func(i, j int) bool {
+		return report.Operators[i].Name < report.Operators[j].Name
+	})
+
+	return report, nil
+}
+
+// Unhealthy returns the reports of the clusterOperators which are unavailable or degraded.
+func (report *HealthReport) Unhealthy() []OperatorReport {
+	var unhealthyOperators []OperatorReport
+
+	for _, operatorReport := range report.Operators {
+		if !operatorReport.IsHealthy() {
+			unhealthyOperators = append(unhealthyOperators, operatorReport)
+		}
+	}
+
+	return unhealthyOperators
+}
+
+// JSON renders the report as indented JSON, suitable for test artifacts.
+func (report *HealthReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(report, "", "  ")
+}
+
+// Table renders the report as a table with one row per clusterOperator, followed by the reasons, messages and related
+// object problems of the unhealthy clusterOperators.
+func (report *HealthReport) Table() string {
+	var output strings.Builder
+
+	writer := tabwriter.NewWriter(&output, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "NAME\tVERSION\tAVAILABLE\tPROGRESSING\tDEGRADED\tUPGRADEABLE\tSINCE")
+
+	for _, operatorReport := range report.Operators {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			operatorReport.Name,
+			operatorReport.Versions[operatorOperandName],
+			operatorReport.conditionStatus(configv1.OperatorAvailable),
+			operatorReport.conditionStatus(configv1.OperatorProgressing),
+			operatorReport.conditionStatus(configv1.OperatorDegraded),
+			operatorReport.conditionStatus(configv1.OperatorUpgradeable),
+			operatorReport.lastTransition(report.GeneratedAt))
+	}
+
+	_ = writer.Flush()
+
+	for _, operatorReport := range report.Unhealthy() {
+		fmt.Fprintf(&output, "\n%s:\n", operatorReport.Name)
+
+		for _, conditionType := range reportedConditions() {
+			condition := operatorReport.Conditions[conditionType]
+			if condition != nil && condition.Message != "" {
+				fmt.Fprintf(&output, "  %s=%s %s: %s\n", conditionType, condition.Status, condition.Reason, condition.Message)
+			}
+		}
+
+		for _, relatedObject := range operatorReport.RelatedObjects {
+			for _, problem := range relatedObject.Problems {
+				fmt.Fprintf(&output, "  %s: %s\n", relatedObject.reference(), problem)
+			}
+		}
+	}
+
+	return output.String()
+}
+
+// conditionStatus returns the status of the condition, or Unknown if the clusterOperator does not report it.
+func (report *OperatorReport) conditionStatus(conditionType configv1.ClusterStatusConditionType) string {
+	if condition := report.Conditions[conditionType]; condition != nil {
+		return string(condition.Status)
+	}
+
+	return string(configv1.ConditionUnknown)
+}
+
+// lastTransition returns the time elapsed since the most recent condition transition.
+func (report *OperatorReport) lastTransition(now time.Time) string {
+	var lastTransitionTime time.Time
+
+	for _, condition := range report.Conditions {
+		if condition.LastTransitionTime.After(lastTransitionTime) {
+			lastTransitionTime = condition.LastTransitionTime
+		}
+	}
+
+	if lastTransitionTime.IsZero() {
+		return ""
+	}
+
+	return now.Sub(lastTransitionTime).Round(time.Second).String()
+}
+
+func (relatedObject *RelatedObjectReport) reference() string {
+	reference := relatedObject.Resource
+
+	if relatedObject.Group != "" {
+		reference += "." + relatedObject.Group
+	}
+
+	reference += "/" + relatedObject.Name
+
+	if relatedObject.Namespace != "" {
+		reference = relatedObject.Namespace + "/" + reference
+	}
+
+	return reference
+}
+
+func newOperatorReport(clusterOperator *configv1.ClusterOperator) OperatorReport {
+	operatorReport := OperatorReport{
+		Name:       clusterOperator.Name,
+		Versions:   make(map[string]string),
+		Conditions: make(map[configv1.ClusterStatusConditionType]*ConditionReport),
+	}
+
+	for _, version := range clusterOperator.Status.Versions {
+		operatorReport.Versions[version.Name] = version.Version
+	}
+
+	for _, condition := range clusterOperator.Status.Conditions {
+		operatorReport.Conditions[condition.Type] = &ConditionReport{
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		}
+	}
+
+	return operatorReport
+}
+
+// inspectRelatedObjects resolves the related objects of a clusterOperator into the problems found on them. Namespaces
+// are inspected for failing pods and warning events, deployments and daemonsets for unavailable replicas. Other
+// resources are reported without inspection.
+func inspectRelatedObjects(
+	apiClient *clients.Settings, relatedObjects []configv1.ObjectReference) []RelatedObjectReport {
+	var relatedReports []RelatedObjectReport
+
+	for _, relatedObject := range relatedObjects {
+		relatedReport := RelatedObjectReport{
+			Group:     relatedObject.Group,
+			Resource:  relatedObject.Resource,
+			Namespace: relatedObject.Namespace,
+			Name:      relatedObject.Name,
+		}
+
+		var err error
+
+		switch {
+		case relatedObject.Group == "" && relatedObject.Resource == "namespaces":
+			relatedReport.Problems, err = inspectNamespace(apiClient, relatedObject.Name)
+		case relatedObject.Group == appsv1.GroupName && relatedObject.Resource == "deployments":
+			relatedReport.Problems, err = inspectDeployment(apiClient, relatedObject.Namespace, relatedObject.Name)
+		case relatedObject.Group == appsv1.GroupName && relatedObject.Resource == "daemonsets":
+			relatedReport.Problems, err = inspectDaemonSet(apiClient, relatedObject.Namespace, relatedObject.Name)
+		}
+
+		if err != nil {
+			relatedReport.Problems = append(relatedReport.Problems, fmt.Sprintf("failed to inspect: %v", err))
+		}
+
+		relatedReports = append(relatedReports, relatedReport)
+	}
+
+	return relatedReports
+}
+
+func inspectNamespace(apiClient *clients.Settings, nsname string) ([]string, error) {
+	var problems []string
+
+	podList, err := apiClient.CoreV1Interface.Pods(nsname).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range podList.Items {
+		if problem := getPodProblem(&pod); problem != "" {
+			problems = append(problems, fmt.Sprintf("pod %s %s", pod.Name, problem))
+		}
+	}
+
+	eventList, err := apiClient.CoreV1Interface.Events(nsname).List(
+		context.TODO(), metav1.ListOptions{FieldSelector: "type=" + corev1.EventTypeWarning})
+	if err != nil {
+		return problems, err
+	}
+
+	events := eventList.Items
+
+	sort.Slice(events, func(i, j int) bool {
+		return getEventLastSeen(&events[i]).After(getEventLastSeen(&events[j]))
+	})
+
+	if len(events) > maxEventsPerNamespace {
+		events = events[:maxEventsPerNamespace]
+	}
+
+	for _, event := range events {
+		problems = append(problems, fmt.Sprintf("event %s %s/%s: %s",
+			event.Reason, strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name, event.Message))
+	}
+
+	return problems, nil
+}
+
+// getEventLastSeen returns the last time the event was observed. Events recorded through events.k8s.io leave
+// LastTimestamp unset and report the time in EventTime, or in the Series once the event repeats.
+func getEventLastSeen(event *corev1.Event) time.Time {
+	lastSeen := event.LastTimestamp.Time
+
+	if event.EventTime.After(lastSeen) {
+		lastSeen = event.EventTime.Time
+	}
+
+	if event.Series != nil && event.Series.LastObservedTime.After(lastSeen) {
+		lastSeen = event.Series.LastObservedTime.Time
+	}
+
+	return lastSeen
+}
+
+func inspectDeployment(apiClient *clients.Settings, nsname, name string) ([]string, error) {
+	deployment, err := apiClient.AppsV1Interface.Deployments(nsname).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	if deployment.Status.AvailableReplicas < replicas {
+		return []string{fmt.Sprintf("%d of %d replicas available", deployment.Status.AvailableReplicas, replicas)}, nil
+	}
+
+	return nil, nil
+}
+
+func inspectDaemonSet(apiClient *clients.Settings, nsname, name string) ([]string, error) {
+	daemonSet, err := apiClient.AppsV1Interface.DaemonSets(nsname).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if daemonSet.Status.NumberAvailable < daemonSet.Status.DesiredNumberScheduled {
+		return []string{fmt.Sprintf("%d of %d pods available",
+			daemonSet.Status.NumberAvailable, daemonSet.Status.DesiredNumberScheduled)}, nil
+	}
+
+	return nil, nil
+}
+
+// getPodProblem returns why the pod is failing, or an empty string if it is running with ready containers or
+// completed.
+func getPodProblem(pod *corev1.Pod) string {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return ""
+	case corev1.PodFailed, corev1.PodPending, corev1.PodUnknown:
+		if pod.Status.Reason != "" {
+			return fmt.Sprintf("is %s: %s", pod.Status.Phase, pod.Status.Reason)
+		}
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Ready {
+			continue
+		}
+
+		if waiting := containerStatus.State.Waiting; waiting != nil && waiting.Reason != "" {
+			return fmt.Sprintf("container %s is waiting: %s (%d restarts)",
+				containerStatus.Name, waiting.Reason, containerStatus.RestartCount)
+		}
+
+		if terminated := containerStatus.State.Terminated; terminated != nil {
+			return fmt.Sprintf("container %s terminated: %s (%d restarts)",
+				containerStatus.Name, terminated.Reason, containerStatus.RestartCount)
+		}
+
+		return fmt.Sprintf("container %s is not ready", containerStatus.Name)
+	}
+
+	if pod.Status.Phase != corev1.PodRunning {
+		return fmt.Sprintf("is %s", pod.Status.Phase)
+	}
+
+	return ""
+}
+
+func reportedConditions() []configv1.ClusterStatusConditionType {
+	return []configv1.ClusterStatusConditionType{
+		configv1.OperatorAvailable,
+		configv1.OperatorProgressing,
+		configv1.OperatorDegraded,
+		configv1.OperatorUpgradeable,
+	}
+}
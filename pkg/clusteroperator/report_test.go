@@ -0,0 +1,233 @@
+package clusteroperator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configV1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGetHealthReport(t *testing.T) {
+	_, err := GetHealthReport(nil)
+	assert.Equal(t, fmt.Errorf("clusterOperator 'apiClient' cannot be empty"), err)
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyHealthReportObjects(),
+		GVK:            []schema.GroupVersionKind{clusterOperatorGVK},
+	})
+
+	report, err := GetHealthReport(testSettings)
+	assert.Nil(t, err)
+	assert.Len(t, report.Operators, 2)
+
+	assert.Equal(t, "dns", report.Operators[0].Name)
+	assert.True(t, report.Operators[0].IsHealthy())
+	assert.Empty(t, report.Operators[0].RelatedObjects)
+	assert.Equal(t, "4.16.3", report.Operators[0].Versions[operatorOperandName])
+
+	unhealthyOperators := report.Unhealthy()
+	assert.Len(t, unhealthyOperators, 1)
+	assert.Equal(t, "network", unhealthyOperators[0].Name)
+	assert.Equal(t, "RolloutHung",
+		unhealthyOperators[0].Conditions[configV1.OperatorDegraded].Reason)
+
+	assert.Equal(t, []RelatedObjectReport{
+		{
+			Resource: "namespaces",
+			Name:     "openshift-network-operator",
+			Problems: []string{
+				"pod network-operator-abc container network-operator is waiting: CrashLoopBackOff (7 restarts)",
+				"event Unhealthy pod/network-operator-abc: Readiness probe failed",
+				"event BackOff pod/network-operator-abc: Back-off restarting failed container",
+			},
+		},
+		{
+			Group:     "apps",
+			Resource:  "deployments",
+			Namespace: "openshift-network-operator",
+			Name:      "network-operator",
+			Problems:  []string{"0 of 1 replicas available"},
+		},
+		{
+			Group:     "apps",
+			Resource:  "daemonsets",
+			Namespace: "openshift-network-operator",
+			Name:      "missing",
+			Problems:  []string{`failed to inspect: daemonsets.apps "missing" not found`},
+		},
+	}, unhealthyOperators[0].RelatedObjects)
+}
+
+func TestGetEventLastSeen(t *testing.T) {
+	lastTimestamp := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		event    corev1.Event
+		expected time.Time
+	}{
+		{
+			event:    corev1.Event{LastTimestamp: metav1.NewTime(lastTimestamp)},
+			expected: lastTimestamp,
+		},
+		{
+			event:    corev1.Event{EventTime: metav1.NewMicroTime(lastTimestamp.Add(time.Minute))},
+			expected: lastTimestamp.Add(time.Minute),
+		},
+		{
+			event: corev1.Event{
+				EventTime: metav1.NewMicroTime(lastTimestamp),
+				Series:    &corev1.EventSeries{LastObservedTime: metav1.NewMicroTime(lastTimestamp.Add(time.Hour))},
+			},
+			expected: lastTimestamp.Add(time.Hour),
+		},
+		{
+			event:    corev1.Event{},
+			expected: time.Time{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.True(t, testCase.expected.Equal(getEventLastSeen(&testCase.event)))
+	}
+}
+
+func TestHealthReportRender(t *testing.T) {
+	generatedAt := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	report := &HealthReport{
+		GeneratedAt: generatedAt,
+		Operators: []OperatorReport{
+			newOperatorReport(buildDummyReportOperator("dns", true, generatedAt.Add(-time.Hour))),
+			newOperatorReport(buildDummyReportOperator("network", false, generatedAt.Add(-time.Minute))),
+		},
+	}
+
+	report.Operators[1].RelatedObjects = []RelatedObjectReport{{
+		Group:     "apps",
+		Resource:  "deployments",
+		Namespace: "openshift-network-operator",
+		Name:      "network-operator",
+		Problems:  []string{"0 of 1 replicas available"},
+	}}
+
+	assert.Equal(t, strings.Join([]string{
+		"NAME     VERSION  AVAILABLE  PROGRESSING  DEGRADED  UPGRADEABLE  SINCE",
+		"dns      4.16.3   True       Unknown      False     Unknown      1h0m0s",
+		"network  4.16.3   True       Unknown      True      Unknown      1m0s",
+		"",
+		"network:",
+		"  Degraded=True RolloutHung: deployment network-operator is not available",
+		"  openshift-network-operator/deployments.apps/network-operator: 0 of 1 replicas available",
+		"",
+	}, "\n"), report.Table())
+
+	output, err := report.JSON()
+	assert.Nil(t, err)
+
+	decodedReport := &HealthReport{}
+	assert.Nil(t, json.Unmarshal(output, decodedReport))
+	assert.Equal(t, report.Operators, decodedReport.Operators)
+}
+
+func buildDummyReportOperator(name string, healthy bool, lastTransitionTime time.Time) *configV1.ClusterOperator {
+	clusterOperator := &configV1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: configV1.ClusterOperatorStatus{
+			Versions: []configV1.OperandVersion{{Name: operatorOperandName, Version: "4.16.3"}},
+			Conditions: []configV1.ClusterOperatorStatusCondition{
+				{
+					Type:               configV1.OperatorAvailable,
+					Status:             configV1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(lastTransitionTime),
+				},
+				{
+					Type:               configV1.OperatorDegraded,
+					Status:             configV1.ConditionFalse,
+					LastTransitionTime: metav1.NewTime(lastTransitionTime),
+				},
+			},
+		},
+	}
+
+	if !healthy {
+		clusterOperator.Status.Conditions[1].Status = configV1.ConditionTrue
+		clusterOperator.Status.Conditions[1].Reason = "RolloutHung"
+		clusterOperator.Status.Conditions[1].Message = "deployment network-operator is not available"
+	}
+
+	return clusterOperator
+}
+
+func buildDummyHealthReportObjects() []runtime.Object {
+	now := time.Now()
+	nsname := "openshift-network-operator"
+	degradedOperator := buildDummyReportOperator("network", false, now)
+	degradedOperator.Status.RelatedObjects = []configV1.ObjectReference{
+		{Resource: "namespaces", Name: nsname},
+		{Group: "apps", Resource: "deployments", Namespace: nsname, Name: "network-operator"},
+		{Group: "apps", Resource: "daemonsets", Namespace: nsname, Name: "missing"},
+	}
+
+	healthyOperator := buildDummyReportOperator("dns", true, now)
+	healthyOperator.Status.RelatedObjects = []configV1.ObjectReference{{Resource: "namespaces", Name: "openshift-dns"}}
+
+	replicas := int32(1)
+
+	return []runtime.Object{
+		degradedOperator,
+		healthyOperator,
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "network-operator-abc", Namespace: nsname},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:         "network-operator",
+					RestartCount: 7,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "network-operator-ready", Namespace: nsname},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "network-operator", Ready: true}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "dns-crashing", Namespace: "openshift-dns"},
+			Status:     corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "network-operator-abc.backoff", Namespace: nsname},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "network-operator-abc"},
+			LastTimestamp:  metav1.NewTime(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "network-operator-abc.unhealthy", Namespace: nsname},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "Unhealthy",
+			Message:        "Readiness probe failed",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "network-operator-abc"},
+			EventTime:      metav1.NewMicroTime(time.Date(2024, 6, 1, 10, 5, 0, 0, time.UTC)),
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "network-operator", Namespace: nsname},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		},
+	}
+}
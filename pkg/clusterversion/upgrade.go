@@ -0,0 +1,672 @@
+package clusterversion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clusteroperator"
+	"github.com/openshift-kni/eco-goinfra/pkg/mco"
+	v1 "github.com/openshift/api/config/v1"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	defaultUpgradeTimeout = 3 * time.Hour
+	// operatorOperandName is the name of the clusteroperator version reporting the version of the operator itself.
+	operatorOperandName = "operator"
+	// conditionFailing is the clusterversion condition set by the CVO when it cannot apply the desired update.
+	conditionFailing v1.ClusterStatusConditionType = "Failing"
+)
+
+var (
+	retryInterval = time.Second * 30
+	// progressPattern matches the completion percentage of the CVO Progressing message, for instance
+	// "Working towards 4.16.3: 106 of 873 done (12% complete)".
+	progressPattern = regexp.MustCompile(`\((\d+)% complete`)
+	// resourceNamePattern matches the resource names, such as clusteroperator names, in the messages of the CVO.
+	resourceNamePattern = regexp.MustCompile(`[a-z0-9]([-a-z0-9.]*[a-z0-9])?`)
+)
+
+// UpgradeEventType is the type of an event emitted during an upgrade.
+type UpgradeEventType string
+
+const (
+	// UpgradeEventStarted is emitted once the desired update is set on the clusterversion.
+	UpgradeEventStarted UpgradeEventType = "Started"
+	// UpgradeEventProgress is emitted when the completion percentage reported by the CVO changes.
+	UpgradeEventProgress UpgradeEventType = "Progress"
+	// UpgradeEventOperatorUpdated is emitted when a clusteroperator reports the target version.
+	UpgradeEventOperatorUpdated UpgradeEventType = "OperatorUpdated"
+	// UpgradeEventPoolPaused is emitted when a machineconfigpool is paused before the upgrade.
+	UpgradeEventPoolPaused UpgradeEventType = "PoolPaused"
+	// UpgradeEventPoolUnpaused is emitted when a machineconfigpool is unpaused after the control plane upgrade or
+	// after a failed upgrade.
+	UpgradeEventPoolUnpaused UpgradeEventType = "PoolUnpaused"
+	// UpgradeEventPoolProgress is emitted when the number of updated machines of a machineconfigpool changes.
+	UpgradeEventPoolProgress UpgradeEventType = "PoolProgress"
+	// UpgradeEventCompleted is emitted once the upgrade is completed.
+	UpgradeEventCompleted UpgradeEventType = "Completed"
+	// UpgradeEventFailed is emitted when the upgrade does not complete.
+	UpgradeEventFailed UpgradeEventType = "Failed"
+)
+
+// UpgradeEvent is a single step of an upgrade timeline.
+type UpgradeEvent struct {
+	// Time is the time at which the event was observed.
+	Time time.Time
+	// Type is the type of the event.
+	Type UpgradeEventType
+	// Name is the name of the clusteroperator or machineconfigpool the event relates to, if any.
+	Name string
+	// Message describes the event.
+	Message string
+}
+
+// UpgradeOptions provides optional settings for Upgrade.
+type UpgradeOptions struct {
+	// AcceptedRisks lists the names of the conditional update risks accepted for the upgrade. Upgrading to a
+	// conditional update fails if any of its risks is not accepted.
+	AcceptedRisks []string
+	// PausedPools lists the machineconfigpools paused during the control plane upgrade, such as worker for an
+	// EUS-to-EUS upgrade. They are unpaused again if the upgrade fails.
+	PausedPools []string
+	// KeepPoolsPaused leaves PausedPools paused once the control plane is upgraded, for the intermediate hop of an
+	// EUS-to-EUS upgrade. Otherwise the pools are unpaused and their rollout is awaited.
+	KeepPoolsPaused bool
+	// Timeout bounds the whole upgrade, including the rollout of the machineconfigpools. Defaults to 3 hours.
+	Timeout time.Duration
+	// OnEvent is called with every event as it is observed. It may be nil.
+	OnEvent func(event UpgradeEvent)
+}
+
+// UpgradeFailure summarizes why an upgrade did not complete.
+type UpgradeFailure struct {
+	// BlockingOperator is the name of the clusteroperator blocking the upgrade, if one could be identified.
+	BlockingOperator string
+	// Reason is the reason of the condition explaining the failure.
+	Reason string
+	// Message is the message of the condition explaining the failure.
+	Message string
+	// PendingOperators lists the clusteroperators not reporting the target version.
+	PendingOperators []string
+	// PendingPools lists the machineconfigpools with machines not updated.
+	PendingPools []string
+}
+
+// String returns a one line summary of the failure.
+func (failure *UpgradeFailure) String() string {
+	summary := "upgrade blocked"
+
+	if failure.BlockingOperator != "" {
+		summary += fmt.Sprintf(" by clusteroperator %s", failure.BlockingOperator)
+	}
+
+	if failure.Reason != "" {
+		summary += fmt.Sprintf(": %s", failure.Reason)
+	}
+
+	if failure.Message != "" {
+		summary += fmt.Sprintf(": %s", failure.Message)
+	}
+
+	if len(failure.PendingOperators) > 0 {
+		summary += fmt.Sprintf(" (pending clusteroperators: %s)", strings.Join(failure.PendingOperators, ", "))
+	}
+
+	if len(failure.PendingPools) > 0 {
+		summary += fmt.Sprintf(" (pending machineconfigpools: %s)", strings.Join(failure.PendingPools, ", "))
+	}
+
+	return summary
+}
+
+// UpgradeReport is the timeline of an upgrade.
+type UpgradeReport struct {
+	// FromVersion is the version of the cluster before the upgrade.
+	FromVersion string
+	// ToVersion is the target version of the upgrade.
+	ToVersion string
+	// StartTime is the time at which the upgrade was requested.
+	StartTime time.Time
+	// EndTime is the time at which the upgrade completed or failed.
+	EndTime time.Time
+	// Timeline lists the events of the upgrade in the order they were observed.
+	Timeline []UpgradeEvent
+	// Failure summarizes why the upgrade did not complete. It is nil for successful upgrades.
+	Failure *UpgradeFailure
+}
+
+// Duration returns the time between the start and the end of the upgrade.
+func (report *UpgradeReport) Duration() time.Duration {
+	return report.EndTime.Sub(report.StartTime)
+}
+
+// String renders the timeline of the upgrade, one event per line, with the time elapsed since the start.
+func (report *UpgradeReport) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Upgrade from %s to %s in %v\n",
+		report.FromVersion, report.ToVersion, report.Duration().Round(time.Second))
+
+	for _, event := range report.Timeline {
+		fmt.Fprintf(&builder, "+%v\t%s", event.Time.Sub(report.StartTime).Round(time.Second), event.Type)
+
+		if event.Name != "" {
+			fmt.Fprintf(&builder, "\t%s", event.Name)
+		}
+
+		fmt.Fprintf(&builder, "\t%s\n", event.Message)
+	}
+
+	if report.Failure != nil {
+		fmt.Fprintf(&builder, "Failure: %s\n", report.Failure.String())
+	}
+
+	return builder.String()
+}
+
+// Upgrade upgrades the cluster to the target version, which must be an available or conditional update. Conditional
+// update risks must be accepted through the options. The given machineconfigpools are paused during the control
+// plane upgrade and the progress of the upgrade is reported through the OnEvent callback. The returned report
+// contains the timeline of the upgrade, and the failure summary if it did not complete in time.
+func (builder *Builder) Upgrade(target string, options *UpgradeOptions) (*UpgradeReport, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Upgrading clusterversion %s to version %s", builder.Definition.Name, target)
+
+	if target == "" {
+		return nil, fmt.Errorf("upgrade target version cannot be empty")
+	}
+
+	options = applyUpgradeDefaults(options)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("%s clusterversion not found", builder.Definition.Name)
+	}
+
+	release, err := resolveUpgradeRelease(builder.Object, target, options.AcceptedRisks)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := newUpgradeTracker(builder.Object.Status.Desired.Version, target, options.OnEvent)
+
+	var pausedPools []*mco.MCPBuilder
+
+	// A failed upgrade must not leave the pools paused, whatever KeepPoolsPaused says, so the pools still paused are
+	// unpaused on the way out. A successful upgrade clears the list when the pools are meant to stay paused.
+	defer func() {
+		if len(pausedPools) == 0 {
+			return
+		}
+
+		_, err := unpausePools(tracker, pausedPools, "machineconfigpool unpaused after failed upgrade")
+		if err != nil {
+			glog.V(100).Infof("Failed to unpause machineconfigpools after failed upgrade: %v", err)
+		}
+	}()
+
+	for _, poolName := range options.PausedPools {
+		pool, err := mco.Pull(builder.apiClient, poolName)
+		if err == nil {
+			err = pool.Pause()
+		}
+
+		if err != nil {
+			err = fmt.Errorf("failed to pause machineconfigpool %s: %w", poolName, err)
+
+			return tracker.fail(&UpgradeFailure{Reason: "PoolPauseFailed", Message: err.Error()}), err
+		}
+
+		pausedPools = append(pausedPools, pool)
+
+		tracker.emit(UpgradeEventPoolPaused, poolName, "machineconfigpool paused")
+	}
+
+	builder.Definition = builder.Object
+	builder.Definition.Spec.DesiredUpdate = &v1.Update{Version: release.Version, Image: release.Image}
+
+	_, err = builder.Update()
+	if err != nil {
+		return tracker.fail(&UpgradeFailure{Reason: "DesiredUpdateFailed", Message: err.Error()}), err
+	}
+
+	tracker.emit(UpgradeEventStarted, "", fmt.Sprintf("desired update set to %s", release.Image))
+
+	deadline := tracker.report.StartTime.Add(options.Timeout)
+
+	err = builder.waitForUpgradeStep(tracker, time.Until(deadline), func() (bool, error) {
+		return isUpdateCompleted(builder.Object, target), nil
+	})
+	if err != nil {
+		return builder.failUpgrade(tracker, err)
+	}
+
+	if !options.KeepPoolsPaused {
+		pausedPools, err = unpausePools(tracker, pausedPools, "machineconfigpool unpaused")
+		if err != nil {
+			return tracker.fail(&UpgradeFailure{Reason: "PoolUnpauseFailed", Message: err.Error()}), err
+		}
+	}
+
+	err = builder.waitForUpgradeStep(tracker, time.Until(deadline), func() (bool, error) {
+		return builder.arePoolsUpdated(options.PausedPools, options.KeepPoolsPaused)
+	})
+	if err != nil {
+		return builder.failUpgrade(tracker, err)
+	}
+
+	pausedPools = nil
+
+	tracker.emit(UpgradeEventCompleted, "", fmt.Sprintf("cluster upgraded to %s", target))
+	tracker.report.EndTime = time.Now()
+
+	return tracker.report, nil
+}
+
+// waitForUpgradeStep waits for timeout duration or until the step is done, feeding the tracker on every poll. It
+// returns early with an error once the CVO reports the clusterversion as Failing.
+func (builder *Builder) waitForUpgradeStep(
+	tracker *upgradeTracker, timeout time.Duration, isDone func() (bool, error)) error {
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			builder.observeUpgrade(tracker)
+
+			if getFailingCondition(builder.Object) != nil {
+				return false, fmt.Errorf("clusterversion %s reports %s=True", builder.Definition.Name, conditionFailing)
+			}
+
+			return isDone()
+		})
+}
+
+// observeUpgrade feeds the tracker with the current state of the clusterversion, clusteroperators and
+// machineconfigpools. Errors are logged and ignored since the API may be briefly unavailable during the upgrade.
+func (builder *Builder) observeUpgrade(tracker *upgradeTracker) {
+	clusterVersion, err := builder.apiClient.ConfigV1Interface.ClusterVersions().Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+	if err != nil {
+		glog.V(100).Infof("Failed to get clusterversion %s: %v", builder.Definition.Name, err)
+	} else {
+		builder.Object = clusterVersion
+		tracker.observeClusterVersion(clusterVersion)
+	}
+
+	operatorList, err := clusteroperator.List(builder.apiClient)
+	if err != nil {
+		glog.V(100).Infof("Failed to list clusteroperators: %v", err)
+	} else {
+		tracker.observeOperators(operatorList)
+	}
+
+	poolList, err := mco.ListMCP(builder.apiClient)
+	if err != nil {
+		glog.V(100).Infof("Failed to list machineconfigpools: %v", err)
+	} else {
+		tracker.observePools(poolList)
+	}
+}
+
+// arePoolsUpdated checks whether every machineconfigpool updated all its machines. Paused pools are skipped when they
+// are meant to stay paused.
+func (builder *Builder) arePoolsUpdated(pausedPools []string, keepPoolsPaused bool) (bool, error) {
+	poolList, err := mco.ListMCP(builder.apiClient)
+	if err != nil {
+		glog.V(100).Infof("Failed to list machineconfigpools: %v", err)
+
+		return false, nil
+	}
+
+	return len(getPendingPools(poolList, pausedPools, keepPoolsPaused)) == 0, nil
+}
+
+// unpausePools unpauses the given machineconfigpools, recording every unpause in the timeline with the given message.
+// It returns the pools which are still paused along with the last failure.
+func unpausePools(
+	tracker *upgradeTracker, pools []*mco.MCPBuilder, message string) ([]*mco.MCPBuilder, error) {
+	var (
+		stillPaused []*mco.MCPBuilder
+		lastErr     error
+	)
+
+	for _, pool := range pools {
+		err := pool.Unpause()
+		if err != nil {
+			glog.V(100).Infof("Failed to unpause machineconfigpool %s: %v", pool.Definition.Name, err)
+
+			stillPaused = append(stillPaused, pool)
+			lastErr = fmt.Errorf("failed to unpause machineconfigpool %s: %w", pool.Definition.Name, err)
+
+			continue
+		}
+
+		tracker.emit(UpgradeEventPoolUnpaused, pool.Definition.Name, message)
+	}
+
+	return stillPaused, lastErr
+}
+
+// failUpgrade fills the report with a summary of what is blocking the upgrade.
+func (builder *Builder) failUpgrade(tracker *upgradeTracker, err error) (*UpgradeReport, error) {
+	failure := &UpgradeFailure{}
+
+	operatorList, listErr := clusteroperator.List(builder.apiClient)
+	if listErr != nil {
+		glog.V(100).Infof("Failed to list clusteroperators: %v", listErr)
+	}
+
+	poolList, listErr := mco.ListMCP(builder.apiClient)
+	if listErr != nil {
+		glog.V(100).Infof("Failed to list machineconfigpools: %v", listErr)
+	}
+
+	summarizeUpgradeFailure(failure, builder.Object, operatorList, tracker.report.ToVersion)
+	failure.PendingPools = getPendingPools(poolList, nil, false)
+
+	tracker.fail(failure)
+
+	return tracker.report, fmt.Errorf("upgrade to %s did not complete: %s: %w",
+		tracker.report.ToVersion, failure.String(), err)
+}
+
+// upgradeTracker records the timeline of an upgrade from the successive observations of the cluster.
+type upgradeTracker struct {
+	report           *UpgradeReport
+	onEvent          func(event UpgradeEvent)
+	percentage       int
+	updatedOperators map[string]bool
+	updatedMachines  map[string]int32
+}
+
+func newUpgradeTracker(fromVersion, toVersion string, onEvent func(event UpgradeEvent)) *upgradeTracker {
+	return &upgradeTracker{
+		report: &UpgradeReport{
+			FromVersion: fromVersion,
+			ToVersion:   toVersion,
+			StartTime:   time.Now(),
+		},
+		onEvent:          onEvent,
+		percentage:       -1,
+		updatedOperators: make(map[string]bool),
+		updatedMachines:  make(map[string]int32),
+	}
+}
+
+// emit appends the event to the timeline and forwards it to the callback, if any.
+func (tracker *upgradeTracker) emit(eventType UpgradeEventType, name, message string) {
+	event := UpgradeEvent{Time: time.Now(), Type: eventType, Name: name, Message: message}
+
+	glog.V(100).Infof("Upgrade event %s %s: %s", eventType, name, message)
+
+	tracker.report.Timeline = append(tracker.report.Timeline, event)
+
+	if tracker.onEvent != nil {
+		tracker.onEvent(event)
+	}
+}
+
+// fail records the failure and closes the report.
+func (tracker *upgradeTracker) fail(failure *UpgradeFailure) *UpgradeReport {
+	tracker.report.Failure = failure
+	tracker.emit(UpgradeEventFailed, failure.BlockingOperator, failure.String())
+	tracker.report.EndTime = time.Now()
+
+	return tracker.report
+}
+
+// observeClusterVersion emits a progress event when the completion percentage reported by the CVO changes.
+func (tracker *upgradeTracker) observeClusterVersion(clusterVersion *v1.ClusterVersion) {
+	for _, condition := range clusterVersion.Status.Conditions {
+		if condition.Type != v1.OperatorProgressing {
+			continue
+		}
+
+		percentage, found := parseProgressPercentage(condition.Message)
+		if found && percentage != tracker.percentage {
+			tracker.percentage = percentage
+			tracker.emit(UpgradeEventProgress, "", condition.Message)
+		}
+	}
+}
+
+// observeOperators emits an event for every clusteroperator newly reporting the target version.
+func (tracker *upgradeTracker) observeOperators(operatorList []*clusteroperator.Builder) {
+	for _, operator := range operatorList {
+		if operator.Object == nil || tracker.updatedOperators[operator.Object.Name] {
+			continue
+		}
+
+		if getOperatorVersion(operator.Object) == tracker.report.ToVersion {
+			tracker.updatedOperators[operator.Object.Name] = true
+			tracker.emit(UpgradeEventOperatorUpdated, operator.Object.Name,
+				fmt.Sprintf("clusteroperator reports version %s", tracker.report.ToVersion))
+		}
+	}
+}
+
+// observePools emits an event for every machineconfigpool whose number of updated machines changed.
+func (tracker *upgradeTracker) observePools(poolList []*mco.MCPBuilder) {
+	for _, pool := range poolList {
+		if pool.Object == nil {
+			continue
+		}
+
+		updatedMachines, found := tracker.updatedMachines[pool.Object.Name]
+		tracker.updatedMachines[pool.Object.Name] = pool.Object.Status.UpdatedMachineCount
+
+		if found && updatedMachines != pool.Object.Status.UpdatedMachineCount {
+			tracker.emit(UpgradeEventPoolProgress, pool.Object.Name, fmt.Sprintf("%d of %d machines updated",
+				pool.Object.Status.UpdatedMachineCount, pool.Object.Status.MachineCount))
+		}
+	}
+}
+
+// resolveUpgradeRelease returns the release of the target version from the available or conditional updates of the
+// clusterversion. Conditional updates are only returned if all their risks are accepted.
+func resolveUpgradeRelease(
+	clusterVersion *v1.ClusterVersion, target string, acceptedRisks []string) (v1.Release, error) {
+	for _, availableUpdate := range clusterVersion.Status.AvailableUpdates {
+		if availableUpdate.Version == target {
+			return availableUpdate, nil
+		}
+	}
+
+	for _, conditionalUpdate := range clusterVersion.Status.ConditionalUpdates {
+		if conditionalUpdate.Release.Version != target {
+			continue
+		}
+
+		var unacceptedRisks []string
+
+		for _, risk := range conditionalUpdate.Risks {
+			if !slices.Contains(acceptedRisks, risk.Name) {
+				unacceptedRisks = append(unacceptedRisks, fmt.Sprintf("%s (%s)", risk.Name, risk.URL))
+			}
+		}
+
+		if len(unacceptedRisks) > 0 {
+			return v1.Release{}, fmt.Errorf("conditional update to %s has unaccepted risks: %s",
+				target, strings.Join(unacceptedRisks, ", "))
+		}
+
+		return conditionalUpdate.Release, nil
+	}
+
+	return v1.Release{}, fmt.Errorf("version %s is neither an available nor a conditional update of %s",
+		target, clusterVersion.Status.Desired.Version)
+}
+
+// summarizeUpgradeFailure identifies the clusteroperators not reporting the target version and the one blocking the
+// upgrade: the operator named by the Failing condition of the clusterversion, else the first degraded or unavailable
+// pending operator, else the first pending operator.
+func summarizeUpgradeFailure(
+	failure *UpgradeFailure,
+	clusterVersion *v1.ClusterVersion,
+	operatorList []*clusteroperator.Builder,
+	target string) {
+	var unhealthyOperator string
+
+	for _, operator := range operatorList {
+		if operator.Object == nil || getOperatorVersion(operator.Object) == target {
+			continue
+		}
+
+		failure.PendingOperators = append(failure.PendingOperators, operator.Object.Name)
+
+		if unhealthyOperator == "" && !isOperatorHealthy(operator.Object) {
+			unhealthyOperator = operator.Object.Name
+		}
+	}
+
+	slices.Sort(failure.PendingOperators)
+
+	if failingCondition := getFailingCondition(clusterVersion); failingCondition != nil {
+		failure.Reason = failingCondition.Reason
+		failure.Message = failingCondition.Message
+	}
+
+	messageNames := resourceNamePattern.FindAllString(failure.Message, -1)
+
+	for _, operator := range failure.PendingOperators {
+		if slices.Contains(messageNames, operator) {
+			failure.BlockingOperator = operator
+
+			return
+		}
+	}
+
+	switch {
+	case unhealthyOperator != "":
+		failure.BlockingOperator = unhealthyOperator
+	case len(failure.PendingOperators) > 0:
+		failure.BlockingOperator = failure.PendingOperators[0]
+	}
+}
+
+// getFailingCondition returns the Failing condition of the clusterversion if it is true, nil otherwise.
+func getFailingCondition(clusterVersion *v1.ClusterVersion) *v1.ClusterOperatorStatusCondition {
+	if clusterVersion == nil {
+		return nil
+	}
+
+	for index, condition := range clusterVersion.Status.Conditions {
+		if condition.Type == conditionFailing && condition.Status == v1.ConditionTrue {
+			return &clusterVersion.Status.Conditions[index]
+		}
+	}
+
+	return nil
+}
+
+// getPendingPools returns the names of the machineconfigpools with machines not updated. Paused pools are skipped
+// when they are meant to stay paused.
+func getPendingPools(poolList []*mco.MCPBuilder, pausedPools []string, keepPoolsPaused bool) []string {
+	var pendingPools []string
+
+	for _, pool := range poolList {
+		if pool.Object == nil || (keepPoolsPaused && slices.Contains(pausedPools, pool.Object.Name)) {
+			continue
+		}
+
+		if !isPoolUpdated(pool.Object) {
+			pendingPools = append(pendingPools, pool.Object.Name)
+		}
+	}
+
+	return pendingPools
+}
+
+// isUpdateCompleted checks whether the clusterversion history reports the update to the target version as completed.
+func isUpdateCompleted(clusterVersion *v1.ClusterVersion, target string) bool {
+	if clusterVersion == nil {
+		return false
+	}
+
+	for _, updateHistory := range clusterVersion.Status.History {
+		if updateHistory.Version == target && updateHistory.State == v1.CompletedUpdate {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPoolUpdated(pool *mcov1.MachineConfigPool) bool {
+	if pool.Status.UpdatedMachineCount != pool.Status.MachineCount {
+		return false
+	}
+
+	for _, condition := range pool.Status.Conditions {
+		if condition.Type == mcov1.MachineConfigPoolUpdated {
+			return condition.Status == isTrue
+		}
+	}
+
+	return false
+}
+
+func isOperatorHealthy(operator *v1.ClusterOperator) bool {
+	for _, condition := range operator.Status.Conditions {
+		switch condition.Type {
+		case v1.OperatorDegraded:
+			if condition.Status == v1.ConditionTrue {
+				return false
+			}
+		case v1.OperatorAvailable:
+			if condition.Status != v1.ConditionTrue {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func getOperatorVersion(operator *v1.ClusterOperator) string {
+	for _, version := range operator.Status.Versions {
+		if version.Name == operatorOperandName {
+			return version.Version
+		}
+	}
+
+	return ""
+}
+
+// parseProgressPercentage returns the completion percentage of the CVO Progressing message.
+func parseProgressPercentage(message string) (int, bool) {
+	match := progressPattern.FindStringSubmatch(message)
+	if match == nil {
+		return 0, false
+	}
+
+	percentage, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return percentage, true
+}
+
+func applyUpgradeDefaults(options *UpgradeOptions) *UpgradeOptions {
+	optionsCopy := UpgradeOptions{}
+
+	if options != nil {
+		optionsCopy = *options
+	}
+
+	if optionsCopy.Timeout == 0 {
+		optionsCopy.Timeout = defaultUpgradeTimeout
+	}
+
+	return &optionsCopy
+}
@@ -0,0 +1,310 @@
+package clusterversion
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/clusteroperator"
+	"github.com/openshift-kni/eco-goinfra/pkg/mco"
+	v1 "github.com/openshift/api/config/v1"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestResolveUpgradeRelease(t *testing.T) {
+	clusterVersion := &v1.ClusterVersion{
+		Status: v1.ClusterVersionStatus{
+			Desired:          v1.Release{Version: "4.15.10"},
+			AvailableUpdates: []v1.Release{{Version: "4.15.12", Image: "quay.io/release:4.15.12"}},
+			ConditionalUpdates: []v1.ConditionalUpdate{{
+				Release: v1.Release{Version: "4.16.3", Image: "quay.io/release:4.16.3"},
+				Risks: []v1.ConditionalUpdateRisk{
+					{Name: "AzureRegression", URL: "https://issues.example.com/1"},
+					{Name: "SDNDeprecation", URL: "https://issues.example.com/2"},
+				},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		target        string
+		acceptedRisks []string
+		expectedImage string
+		expectedError error
+	}{
+		{
+			target:        "4.15.12",
+			expectedImage: "quay.io/release:4.15.12",
+			expectedError: nil,
+		},
+		{
+			target:        "4.16.3",
+			acceptedRisks: []string{"AzureRegression", "SDNDeprecation"},
+			expectedImage: "quay.io/release:4.16.3",
+			expectedError: nil,
+		},
+		{
+			target:        "4.16.3",
+			acceptedRisks: []string{"AzureRegression"},
+			expectedError: fmt.Errorf(
+				"conditional update to 4.16.3 has unaccepted risks: SDNDeprecation (https://issues.example.com/2)"),
+		},
+		{
+			target:        "4.17.0",
+			expectedError: fmt.Errorf("version 4.17.0 is neither an available nor a conditional update of 4.15.10"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		release, err := resolveUpgradeRelease(clusterVersion, testCase.target, testCase.acceptedRisks)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedImage, release.Image)
+	}
+}
+
+func TestParseProgressPercentage(t *testing.T) {
+	percentage, found := parseProgressPercentage("Working towards 4.16.3: 106 of 873 done (12% complete)")
+	assert.True(t, found)
+	assert.Equal(t, 12, percentage)
+
+	percentage, found = parseProgressPercentage(
+		"Working towards 4.16.3: 700 of 873 done (80% complete), waiting on machine-config")
+	assert.True(t, found)
+	assert.Equal(t, 80, percentage)
+
+	_, found = parseProgressPercentage("Cluster version is 4.16.3")
+	assert.False(t, found)
+}
+
+func TestUpgradeTracker(t *testing.T) {
+	var events []UpgradeEvent
+
+	tracker := newUpgradeTracker("4.15.10", "4.16.3", func(event UpgradeEvent) {
+		events = append(events, event)
+	})
+
+	tracker.observeClusterVersion(buildDummyUpgradingClusterVersion("Working towards 4.16.3: 1 of 873 done (0% complete)"))
+	tracker.observeClusterVersion(buildDummyUpgradingClusterVersion("Working towards 4.16.3: 2 of 873 done (0% complete)"))
+	tracker.observeClusterVersion(
+		buildDummyUpgradingClusterVersion("Working towards 4.16.3: 106 of 873 done (12% complete)"))
+
+	tracker.observeOperators([]*clusteroperator.Builder{
+		buildDummyOperator("etcd", "4.16.3", true), buildDummyOperator("dns", "4.15.10", true)})
+	tracker.observeOperators([]*clusteroperator.Builder{
+		buildDummyOperator("etcd", "4.16.3", true), buildDummyOperator("dns", "4.16.3", true)})
+
+	tracker.observePools([]*mco.MCPBuilder{buildDummyPool("worker", 0, 3, false)})
+	tracker.observePools([]*mco.MCPBuilder{buildDummyPool("worker", 0, 3, false)})
+	tracker.observePools([]*mco.MCPBuilder{buildDummyPool("worker", 1, 3, false)})
+
+	var eventTypes []UpgradeEventType
+	for _, event := range events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+
+	assert.Equal(t, []UpgradeEventType{
+		UpgradeEventProgress, UpgradeEventProgress,
+		UpgradeEventOperatorUpdated, UpgradeEventOperatorUpdated,
+		UpgradeEventPoolProgress,
+	}, eventTypes)
+	assert.Equal(t, events, tracker.report.Timeline)
+	assert.Equal(t, "etcd", events[2].Name)
+	assert.Equal(t, "dns", events[3].Name)
+	assert.Equal(t, "1 of 3 machines updated", events[4].Message)
+}
+
+func TestSummarizeUpgradeFailure(t *testing.T) {
+	operatorList := []*clusteroperator.Builder{
+		buildDummyOperator("etcd", "4.16.3", true),
+		buildDummyOperator("network", "4.15.10", true),
+		buildDummyOperator("machine-config", "4.15.10", false),
+	}
+
+	failure := &UpgradeFailure{}
+	summarizeUpgradeFailure(failure, buildDummyUpgradingClusterVersion(""), operatorList, "4.16.3")
+	assert.Equal(t, "machine-config", failure.BlockingOperator)
+	assert.Equal(t, []string{"machine-config", "network"}, failure.PendingOperators)
+
+	clusterVersion := buildDummyUpgradingClusterVersion("")
+	clusterVersion.Status.Conditions = append(clusterVersion.Status.Conditions, v1.ClusterOperatorStatusCondition{
+		Type:    conditionFailing,
+		Status:  v1.ConditionTrue,
+		Reason:  "ClusterOperatorNotAvailable",
+		Message: "Cluster operator network is not available",
+	})
+
+	failure = &UpgradeFailure{PendingPools: []string{"worker"}}
+	summarizeUpgradeFailure(failure, clusterVersion, operatorList, "4.16.3")
+	assert.Equal(t, "network", failure.BlockingOperator)
+	assert.Equal(t, "upgrade blocked by clusteroperator network: ClusterOperatorNotAvailable: "+
+		"Cluster operator network is not available (pending clusteroperators: machine-config, network) "+
+		"(pending machineconfigpools: worker)", failure.String())
+}
+
+func TestGetFailingCondition(t *testing.T) {
+	testCases := []struct {
+		status          v1.ConditionStatus
+		clusterVersion  bool
+		expectedFailing bool
+	}{
+		{
+			status:          v1.ConditionTrue,
+			clusterVersion:  true,
+			expectedFailing: true,
+		},
+		{
+			status:          v1.ConditionFalse,
+			clusterVersion:  true,
+			expectedFailing: false,
+		},
+		{
+			clusterVersion:  false,
+			expectedFailing: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var clusterVersion *v1.ClusterVersion
+
+		if testCase.clusterVersion {
+			clusterVersion = buildDummyUpgradingClusterVersion("")
+			clusterVersion.Status.Conditions = append(clusterVersion.Status.Conditions,
+				v1.ClusterOperatorStatusCondition{Type: conditionFailing, Status: testCase.status, Reason: "UpdatePayloadFailed"})
+		}
+
+		failingCondition := getFailingCondition(clusterVersion)
+		assert.Equal(t, testCase.expectedFailing, failingCondition != nil)
+
+		if testCase.expectedFailing {
+			assert.Equal(t, "UpdatePayloadFailed", failingCondition.Reason)
+		}
+	}
+}
+
+func TestGetPendingPools(t *testing.T) {
+	poolList := []*mco.MCPBuilder{
+		buildDummyPool("master", 3, 3, true),
+		buildDummyPool("worker", 1, 3, false),
+		buildDummyPool("infra", 2, 2, false),
+	}
+
+	assert.Equal(t, []string{"worker", "infra"}, getPendingPools(poolList, nil, false))
+	assert.Equal(t, []string{"infra"}, getPendingPools(poolList, []string{"worker"}, true))
+	assert.Equal(t, []string{"worker", "infra"}, getPendingPools(poolList, []string{"worker"}, false))
+}
+
+func TestUpgradeReportString(t *testing.T) {
+	startTime := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	report := &UpgradeReport{
+		FromVersion: "4.15.10",
+		ToVersion:   "4.16.3",
+		StartTime:   startTime,
+		EndTime:     startTime.Add(time.Hour),
+		Timeline: []UpgradeEvent{
+			{Time: startTime, Type: UpgradeEventStarted, Message: "desired update set"},
+			{Time: startTime.Add(10 * time.Minute), Type: UpgradeEventOperatorUpdated, Name: "etcd", Message: "updated"},
+		},
+		Failure: &UpgradeFailure{BlockingOperator: "network"},
+	}
+
+	assert.Equal(t, time.Hour, report.Duration())
+	assert.Equal(t, strings.Join([]string{
+		"Upgrade from 4.15.10 to 4.16.3 in 1h0m0s",
+		"+0s\tStarted\tdesired update set",
+		"+10m0s\tOperatorUpdated\tetcd\tupdated",
+		"Failure: upgrade blocked by clusteroperator network",
+		"",
+	}, "\n"), report.String())
+}
+
+func TestUnpausePools(t *testing.T) {
+	pausedPool := &mcov1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec:       mcov1.MachineConfigPoolSpec{Paused: true},
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{pausedPool}})
+	tracker := newUpgradeTracker("4.15.10", "4.16.3", nil)
+
+	stillPaused, err := unpausePools(tracker, []*mco.MCPBuilder{
+		mco.NewMCPBuilder(testSettings, "worker"), mco.NewMCPBuilder(testSettings, "infra")},
+		"machineconfigpool unpaused after failed upgrade")
+	assert.Equal(t, fmt.Errorf("failed to unpause machineconfigpool infra: %w",
+		fmt.Errorf("MachineConfigPool infra object does not exist")), err)
+	assert.Len(t, stillPaused, 1)
+	assert.Equal(t, "infra", stillPaused[0].Definition.Name)
+
+	assert.Len(t, tracker.report.Timeline, 1)
+	assert.Equal(t, UpgradeEventPoolUnpaused, tracker.report.Timeline[0].Type)
+	assert.Equal(t, "worker", tracker.report.Timeline[0].Name)
+
+	workerPool, err := mco.Pull(testSettings, "worker")
+	assert.Nil(t, err)
+	assert.False(t, workerPool.Object.Spec.Paused)
+}
+
+func TestUpgradeValidation(t *testing.T) {
+	var nilBuilder *Builder
+
+	_, err := nilBuilder.Upgrade("4.16.3", nil)
+	assert.Equal(t, fmt.Errorf("error: received nil ClusterVersion builder"), err)
+
+	assert.Equal(t, defaultUpgradeTimeout, applyUpgradeDefaults(nil).Timeout)
+	assert.Equal(t, time.Hour, applyUpgradeDefaults(&UpgradeOptions{Timeout: time.Hour}).Timeout)
+}
+
+func buildDummyUpgradingClusterVersion(progressMessage string) *v1.ClusterVersion {
+	return &v1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterVersionName},
+		Status: v1.ClusterVersionStatus{
+			Conditions: []v1.ClusterOperatorStatusCondition{{
+				Type:    v1.OperatorProgressing,
+				Status:  v1.ConditionTrue,
+				Message: progressMessage,
+			}},
+		},
+	}
+}
+
+func buildDummyOperator(name, version string, healthy bool) *clusteroperator.Builder {
+	availableStatus := v1.ConditionTrue
+	if !healthy {
+		availableStatus = v1.ConditionFalse
+	}
+
+	operator := &v1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.ClusterOperatorStatus{
+			Versions:   []v1.OperandVersion{{Name: operatorOperandName, Version: version}},
+			Conditions: []v1.ClusterOperatorStatusCondition{{Type: v1.OperatorAvailable, Status: availableStatus}},
+		},
+	}
+
+	return &clusteroperator.Builder{Definition: operator, Object: operator}
+}
+
+func buildDummyPool(name string, updatedMachines, machines int32, updated bool) *mco.MCPBuilder {
+	updatedStatus := corev1.ConditionFalse
+	if updated {
+		updatedStatus = corev1.ConditionTrue
+	}
+
+	pool := &mcov1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: mcov1.MachineConfigPoolStatus{
+			MachineCount:        machines,
+			UpdatedMachineCount: updatedMachines,
+			Conditions: []mcov1.MachineConfigPoolCondition{
+				{Type: mcov1.MachineConfigPoolUpdated, Status: updatedStatus},
+			},
+		},
+	}
+
+	return &mco.MCPBuilder{Definition: pool, Object: pool}
+}
@@ -13,7 +13,6 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
-	appsv1Typed "k8s.io/client-go/kubernetes/typed/apps/v1"
 )
 
 // Builder provides struct for daemonset object containing connection to the cluster and the daemonset definitions.
@@ -25,7 +24,7 @@ type Builder struct {
 	// Used in functions that define or mutate daemonset definition. errorMsg is processed before the daemonset
 	// object is created.
 	errorMsg  string
-	apiClient appsv1Typed.DaemonSetInterface
+	apiClient *clients.Settings
 }
 
 // AdditionalOptions additional options for daemonset object.
@@ -48,7 +47,7 @@ func NewBuilder(
 	}
 
 	builder := &Builder{
-		apiClient: apiClient.DaemonSets(nsname),
+		apiClient: apiClient,
 		Definition: &appsv1.DaemonSet{
 			Spec: appsv1.DaemonSetSpec{
 				Selector: &metav1.LabelSelector{
@@ -107,7 +106,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	builder := &Builder{
-		apiClient: apiClient.DaemonSets(nsname),
+		apiClient: apiClient,
 		Definition: &appsv1.DaemonSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -258,7 +257,7 @@ func (builder *Builder) Create() (*Builder, error) {
 
 	var err error
 	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.Create(
+		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
 
@@ -274,7 +273,7 @@ func (builder *Builder) Update() (*Builder, error) {
 	glog.V(100).Infof("Updating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.apiClient.Update(
+	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
@@ -295,7 +294,7 @@ func (builder *Builder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(
+	err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Delete(
 		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
@@ -326,7 +325,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 	// Polls every retryInterval to determine if daemonset is available.
 	err = wait.PollUntilContextTimeout(
 		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			builder.Object, err = builder.apiClient.Get(
+			builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
 				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
@@ -365,7 +364,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	// Polls the daemonset every retryInterval until it is removed.
 	return wait.PollUntilContextTimeout(
 		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			_, err := builder.apiClient.Get(
+			_, err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
 				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
@@ -385,7 +384,7 @@ func (builder *Builder) Exists() bool {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.apiClient.Get(
+	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -404,11 +403,11 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 	err := wait.PollUntilContextTimeout(
 		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			if !builder.Exists() {
-				return false, fmt.Errorf("daemonset %s is not present on cluster", builder.Object.Name)
+				return false, fmt.Errorf("daemonset %s is not present on cluster", builder.Definition.Name)
 			}
 
 			var err error
-			builder.Object, err = builder.apiClient.Get(
+			builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
 				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
@@ -417,16 +416,12 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 				return false, nil
 			}
 
-			if builder.Object.Status.NumberReady == builder.Object.Status.DesiredNumberScheduled {
-				return true, nil
-			}
-
-			if builder.Object.Status.NumberReady == builder.Object.Status.UpdatedNumberScheduled &&
-				builder.Object.Status.UpdatedNumberScheduled != 0 {
-				return true, nil
+			if builder.Object.Status.ObservedGeneration < builder.Object.Generation {
+				return false, nil
 			}
 
-			return false, err
+			return builder.Object.Status.UpdatedNumberScheduled == builder.Object.Status.DesiredNumberScheduled &&
+				builder.Object.Status.NumberReady == builder.Object.Status.DesiredNumberScheduled, nil
 		})
 
 	return err == nil
@@ -0,0 +1,206 @@
+package daemonset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WithMaxUnavailable sets the maximum number or percentage of daemonset pods that can be unavailable during a
+// rolling update.
+func (builder *Builder) WithMaxUnavailable(maxUnavailable intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting maxUnavailable %s in daemonset %s in namespace %s",
+		maxUnavailable.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateRollingUpdateValue(maxUnavailable); err != nil {
+		builder.errorMsg = fmt.Sprintf("daemonset 'maxUnavailable' is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.rollingUpdate().MaxUnavailable = &maxUnavailable
+
+	return builder
+}
+
+// WithMaxSurge sets the maximum number or percentage of nodes that can run an updated daemonset pod alongside the
+// old one during a rolling update.
+func (builder *Builder) WithMaxSurge(maxSurge intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting maxSurge %s in daemonset %s in namespace %s",
+		maxSurge.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateRollingUpdateValue(maxSurge); err != nil {
+		builder.errorMsg = fmt.Sprintf("daemonset 'maxSurge' is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.rollingUpdate().MaxSurge = &maxSurge
+
+	return builder
+}
+
+// WaitForRolloutComplete waits for the duration of the defined timeout or until the latest rollout of the daemonset
+// is complete, meaning the controller observed the latest generation and the updated pods are scheduled and
+// available on every eligible node.
+func (builder *Builder) WaitForRolloutComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until rollout of daemonset %s in namespace %s is complete",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for rollout of daemonset %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				glog.V(100).Infof("Failed to get daemonset from cluster. Error is: '%s'", err.Error())
+
+				return false, nil
+			}
+
+			return isRolloutComplete(builder.Object), nil
+		})
+}
+
+// GetOwnedPods returns the pods controlled by the daemonset.
+func (builder *Builder) GetOwnedPods() ([]*pod.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Listing pods owned by daemonset %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("daemonset object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return pod.ListControlledBy(builder.apiClient, builder.Object, builder.Object.Spec.Selector)
+}
+
+// GetPods returns the pods controlled by the daemonset that run the latest revision of its pod template. The latest
+// revision is taken from the newest ControllerRevision owned by the daemonset.
+func (builder *Builder) GetPods() ([]*pod.Builder, error) {
+	ownedPods, err := builder.GetOwnedPods()
+	if err != nil {
+		return nil, err
+	}
+
+	revisionHash, err := builder.getLatestRevisionHash()
+	if err != nil {
+		return nil, err
+	}
+
+	if revisionHash == "" {
+		glog.V(100).Infof("Daemonset %s has no controller revision yet", builder.Definition.Name)
+
+		return ownedPods, nil
+	}
+
+	var revisionPods []*pod.Builder
+
+	for _, ownedPod := range ownedPods {
+		if ownedPod.Object.Labels[appsv1.ControllerRevisionHashLabelKey] == revisionHash {
+			revisionPods = append(revisionPods, ownedPod)
+		}
+	}
+
+	return revisionPods, nil
+}
+
+// getLatestRevisionHash returns the hash of the newest ControllerRevision owned by the daemonset, or an empty string
+// if there is none.
+func (builder *Builder) getLatestRevisionHash() (string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.Selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse selector of daemonset %s: %w", builder.Definition.Name, err)
+	}
+
+	revisionList, err := builder.apiClient.ControllerRevisions(builder.Definition.Namespace).List(
+		context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return "", fmt.Errorf("failed to list controller revisions of daemonset %s: %w", builder.Definition.Name, err)
+	}
+
+	var latestRevision *appsv1.ControllerRevision
+
+	for index := range revisionList.Items {
+		revision := &revisionList.Items[index]
+
+		if !metav1.IsControlledBy(revision, builder.Object) {
+			continue
+		}
+
+		if latestRevision == nil || revision.Revision > latestRevision.Revision {
+			latestRevision = revision
+		}
+	}
+
+	if latestRevision == nil {
+		return "", nil
+	}
+
+	return latestRevision.Labels[appsv1.ControllerRevisionHashLabelKey], nil
+}
+
+// rollingUpdate switches the update strategy of the definition to RollingUpdate and returns its settings.
+func (builder *Builder) rollingUpdate() *appsv1.RollingUpdateDaemonSet {
+	builder.Definition.Spec.UpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
+
+	if builder.Definition.Spec.UpdateStrategy.RollingUpdate == nil {
+		builder.Definition.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{}
+	}
+
+	return builder.Definition.Spec.UpdateStrategy.RollingUpdate
+}
+
+// isRolloutComplete reports whether the rollout of the daemonset is complete using the same checks as kubectl
+// rollout status.
+func isRolloutComplete(daemonSet *appsv1.DaemonSet) bool {
+	if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+		return false
+	}
+
+	return daemonSet.Status.UpdatedNumberScheduled >= daemonSet.Status.DesiredNumberScheduled &&
+		daemonSet.Status.NumberAvailable >= daemonSet.Status.DesiredNumberScheduled
+}
+
+func validateRollingUpdateValue(value intstr.IntOrString) error {
+	if value.Type == intstr.String {
+		_, err := intstr.GetScaledValueFromIntOrPercent(&value, 100, true)
+
+		return err
+	}
+
+	if value.IntVal < 0 {
+		return fmt.Errorf("value cannot be negative")
+	}
+
+	return nil
+}
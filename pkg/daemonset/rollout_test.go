@@ -0,0 +1,161 @@
+package daemonset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestDaemonsetWithMaxUnavailableAndMaxSurge(t *testing.T) {
+	testBuilder := buildValidTestBuilderWithClient(nil).
+		WithMaxUnavailable(intstr.FromInt32(0)).
+		WithMaxSurge(intstr.FromString("25%"))
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, appsv1.RollingUpdateDaemonSetStrategyType, testBuilder.Definition.Spec.UpdateStrategy.Type)
+	assert.Equal(t, intstr.FromInt32(0), *testBuilder.Definition.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable)
+	assert.Equal(t, intstr.FromString("25%"), *testBuilder.Definition.Spec.UpdateStrategy.RollingUpdate.MaxSurge)
+
+	testBuilder = buildValidTestBuilderWithClient(nil).WithMaxUnavailable(intstr.FromInt32(-1))
+	assert.Equal(t, "daemonset 'maxUnavailable' is invalid: value cannot be negative", testBuilder.errorMsg)
+
+	testBuilder = buildValidTestBuilderWithClient(nil).WithMaxSurge(intstr.FromString("many"))
+	assert.Equal(t, "daemonset 'maxSurge' is invalid: invalid value for IntOrString: "+
+		"invalid type: string is not a percentage", testBuilder.errorMsg)
+}
+
+func TestDaemonsetIsReady(t *testing.T) {
+	testCases := []struct {
+		mutate   func(daemonSet *appsv1.DaemonSet)
+		expected bool
+	}{
+		{
+			mutate:   func(daemonSet *appsv1.DaemonSet) {},
+			expected: true,
+		},
+		{
+			mutate:   func(daemonSet *appsv1.DaemonSet) { daemonSet.Status.UpdatedNumberScheduled = 1 },
+			expected: false,
+		},
+		{
+			mutate:   func(daemonSet *appsv1.DaemonSet) { daemonSet.Generation = 2 },
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testDaemonSet := buildTestDaemonSet()
+		testCase.mutate(testDaemonSet)
+
+		testBuilder := buildValidTestBuilderWithClient([]runtime.Object{testDaemonSet})
+		assert.Equal(t, testCase.expected, testBuilder.IsReady(time.Second))
+	}
+}
+
+func TestDaemonsetWaitForRolloutComplete(t *testing.T) {
+	testBuilder := buildValidTestBuilderWithClient([]runtime.Object{buildTestDaemonSet()})
+	assert.Nil(t, testBuilder.WaitForRolloutComplete(time.Second))
+
+	testDaemonSet := buildTestDaemonSet()
+	testDaemonSet.Status.NumberAvailable = 2
+
+	testBuilder = buildValidTestBuilderWithClient([]runtime.Object{testDaemonSet})
+	assert.NotNil(t, testBuilder.WaitForRolloutComplete(time.Second))
+}
+
+func TestDaemonsetGetOwnedPods(t *testing.T) {
+	testBuilder := buildValidTestBuilderWithClient([]runtime.Object{
+		buildTestDaemonSet(),
+		buildTestDaemonSetPod("test-name-abcde", "test-uid"),
+		buildTestDaemonSetPod("other-abcde", "other-uid"),
+	})
+
+	pods, err := testBuilder.GetOwnedPods()
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "test-name-abcde", pods[0].Object.Name)
+}
+
+func buildTestDaemonSet() *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-name",
+			Namespace:  "test-namespace",
+			UID:        types.UID("test-uid"),
+			Generation: 1,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"test-key": "test-value"}},
+		},
+		Status: appsv1.DaemonSetStatus{
+			ObservedGeneration:     1,
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 3,
+			NumberReady:            3,
+			NumberAvailable:        3,
+		},
+	}
+}
+
+func buildTestDaemonSetPod(name, ownerUID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"test-key": "test-value"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "DaemonSet",
+				Name:       "test-name",
+				UID:        types.UID(ownerUID),
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+}
+
+func TestDaemonsetGetPods(t *testing.T) {
+	oldPod := buildTestDaemonSetPod("test-name-old", "test-uid")
+	oldPod.Labels[appsv1.ControllerRevisionHashLabelKey] = "hash-1"
+
+	newPod := buildTestDaemonSetPod("test-name-new", "test-uid")
+	newPod.Labels[appsv1.ControllerRevisionHashLabelKey] = "hash-2"
+
+	testBuilder := buildValidTestBuilderWithClient([]runtime.Object{
+		buildTestDaemonSet(),
+		oldPod,
+		newPod,
+		buildTestControllerRevision("test-name-hash-1", "hash-1", 1),
+		buildTestControllerRevision("test-name-hash-2", "hash-2", 2),
+	})
+
+	pods, err := testBuilder.GetPods()
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "test-name-new", pods[0].Object.Name)
+}
+
+func buildTestControllerRevision(name, hash string, revision int64) *appsv1.ControllerRevision {
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"test-key": "test-value", appsv1.ControllerRevisionHashLabelKey: hash},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "DaemonSet",
+				Name:       "test-name",
+				UID:        types.UID("test-uid"),
+				Controller: ptr.To(true),
+			}},
+		},
+		Revision: revision,
+	}
+}
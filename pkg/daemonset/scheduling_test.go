@@ -0,0 +1,28 @@
+package daemonset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDaemonsetWithSchedulingOptions(t *testing.T) {
+	testBuilder := buildValidTestBuilderWithClient(nil).
+		WithNodeAffinity(&corev1.NodeAffinity{}).
+		WithPriorityClassName("system-node-critical").
+		WithRuntimeClassName("performance-test").
+		WithDNSConfig(corev1.DNSClusterFirstWithHostNet, nil).
+		WithHostAlias(corev1.HostAlias{IP: "10.0.0.1", Hostnames: []string{"registry.local"}})
+	assert.Empty(t, testBuilder.errorMsg)
+
+	podSpec := testBuilder.Definition.Spec.Template.Spec
+	assert.NotNil(t, podSpec.Affinity.NodeAffinity)
+	assert.Equal(t, "system-node-critical", podSpec.PriorityClassName)
+	assert.Equal(t, "performance-test", *podSpec.RuntimeClassName)
+	assert.Equal(t, corev1.DNSClusterFirstWithHostNet, podSpec.DNSPolicy)
+	assert.Len(t, podSpec.HostAliases, 1)
+
+	testBuilder = buildValidTestBuilderWithClient(nil).WithHostAlias(corev1.HostAlias{IP: "10.0.0.1"})
+	assert.Equal(t, "daemonset 'hostAlias' hostnames cannot be empty", testBuilder.errorMsg)
+}
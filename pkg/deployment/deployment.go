@@ -17,7 +17,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
-	appsv1Typed "k8s.io/client-go/kubernetes/typed/apps/v1"
 )
 
 // Builder provides struct for deployment object containing connection to the cluster and the deployment definitions.
@@ -29,7 +28,7 @@ type Builder struct {
 	// Used in functions that define or mutate deployment definition. errorMsg is processed before the deployment
 	// object is created.
 	errorMsg  string
-	apiClient appsv1Typed.AppsV1Interface
+	apiClient *clients.Settings
 }
 
 // AdditionalOptions additional options for deployment object.
@@ -44,7 +43,7 @@ func NewBuilder(
 		name, nsname, labels, containerSpec)
 
 	builder := Builder{
-		apiClient: apiClient.AppsV1Interface,
+		apiClient: apiClient,
 		Definition: &appsv1.Deployment{
 			Spec: appsv1.DeploymentSpec{
 				Selector: &metav1.LabelSelector{
@@ -98,7 +97,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing deployment name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
-		apiClient: apiClient.AppsV1Interface,
+		apiClient: apiClient,
 		Definition: &appsv1.Deployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -488,6 +487,13 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 				return false, fmt.Errorf(err.Error())
 			}
 
+			if builder.Object.Status.ObservedGeneration < builder.Object.Generation {
+				glog.V(100).Infof("Deployment %s in namespace %s has not observed generation %d yet",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Generation)
+
+				return false, nil
+			}
+
 			if builder.Object.Status.ReadyReplicas > 0 && builder.Object.Status.Replicas == builder.Object.Status.ReadyReplicas {
 				return true, nil
 			}
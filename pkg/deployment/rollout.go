@@ -0,0 +1,333 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/replicaset"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// RevisionAnnotation is the annotation the deployment controller uses to record the revision of a deployment
+	// and of its replicasets.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// RestartedAtAnnotation is the pod template annotation used to trigger a rollout restart.
+	RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+	progressDeadlineExceededReason = "ProgressDeadlineExceeded"
+)
+
+// Revision represents a single entry in the rollout history of a deployment.
+type Revision struct {
+	// Number is the revision number recorded by the deployment controller.
+	Number int64
+	// ReplicaSet is the replicaset holding the pod template of the revision.
+	ReplicaSet *replicaset.Builder
+}
+
+// RolloutRestart triggers a rolling restart of the deployment pods by updating the restartedAt annotation of the pod
+// template, equivalent to kubectl rollout restart.
+func (builder *Builder) RolloutRestart() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Restarting rollout of deployment %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.updateLatest(func(deployment *appsv1.Deployment) error {
+		if deployment.Spec.Template.Annotations == nil {
+			deployment.Spec.Template.Annotations = map[string]string{}
+		}
+
+		deployment.Spec.Template.Annotations[RestartedAtAnnotation] = time.Now().Format(time.RFC3339)
+
+		return nil
+	})
+}
+
+// SetImage changes the image of the given container in the deployment, which starts a new rollout.
+func (builder *Builder) SetImage(containerName, image string) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Setting image of container %s to %s in deployment %s in namespace %s",
+		containerName, image, builder.Definition.Name, builder.Definition.Namespace)
+
+	if containerName == "" {
+		return builder, fmt.Errorf("deployment 'containerName' cannot be empty")
+	}
+
+	if image == "" {
+		return builder, fmt.Errorf("deployment 'image' cannot be empty")
+	}
+
+	return builder.updateLatest(func(deployment *appsv1.Deployment) error {
+		for index := range deployment.Spec.Template.Spec.Containers {
+			if deployment.Spec.Template.Spec.Containers[index].Name == containerName {
+				deployment.Spec.Template.Spec.Containers[index].Image = image
+
+				return nil
+			}
+		}
+
+		for index := range deployment.Spec.Template.Spec.InitContainers {
+			if deployment.Spec.Template.Spec.InitContainers[index].Name == containerName {
+				deployment.Spec.Template.Spec.InitContainers[index].Image = image
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("container %s does not exist in deployment %s", containerName, deployment.Name)
+	})
+}
+
+// Scale changes the number of replicas of the deployment and waits for the duration of the defined timeout or
+// until the rollout of the new replica count is complete.
+func (builder *Builder) Scale(replicas int32, timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Scaling deployment %s in namespace %s to %d replicas",
+		builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if replicas < 0 {
+		return builder, fmt.Errorf("deployment 'replicas' cannot be negative")
+	}
+
+	builder, err := builder.updateLatest(func(deployment *appsv1.Deployment) error {
+		deployment.Spec.Replicas = &replicas
+
+		return nil
+	})
+	if err != nil {
+		return builder, err
+	}
+
+	return builder, builder.WaitForRolloutComplete(timeout)
+}
+
+// WaitForRolloutComplete waits for the duration of the defined timeout or until the latest rollout of the
+// deployment is complete, meaning the controller observed the latest generation and all replicas are updated and
+// available. An error is returned early if the rollout exceeds its progress deadline.
+func (builder *Builder) WaitForRolloutComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until rollout of deployment %s in namespace %s is complete",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for rollout of deployment %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				glog.V(100).Infof("Failed to get deployment from cluster. Error is: '%s'", err.Error())
+
+				return false, nil
+			}
+
+			return isRolloutComplete(builder.Object)
+		})
+}
+
+// GetRevisionHistory returns the rollout history of the deployment sorted from the oldest to the newest revision.
+// The history is built from the replicasets owned by the deployment.
+func (builder *Builder) GetRevisionHistory() ([]Revision, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting revision history of deployment %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("deployment object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector of deployment %s: %w", builder.Definition.Name, err)
+	}
+
+	replicasets, err := replicaset.List(builder.apiClient, builder.Definition.Namespace, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []Revision
+
+	for _, replicasetBuilder := range replicasets {
+		if !metav1.IsControlledBy(replicasetBuilder.Object, builder.Object) {
+			continue
+		}
+
+		revision, err := strconv.ParseInt(replicasetBuilder.Object.Annotations[RevisionAnnotation], 10, 64)
+		if err != nil {
+			glog.V(100).Infof("Skipping replicaset %s with invalid revision annotation", replicasetBuilder.Object.Name)
+
+			continue
+		}
+
+		revisions = append(revisions, Revision{Number: revision, ReplicaSet: replicasetBuilder})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Number < revisions[j].Number
+	})
+
+	return revisions, nil
+}
+
+// RollbackTo restores the pod template of the given revision, equivalent to kubectl rollout undo --to-revision. A
+// revision of 0 rolls back to the revision preceding the current one.
+func (builder *Builder) RollbackTo(revision int64) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Rolling back deployment %s in namespace %s to revision %d",
+		builder.Definition.Name, builder.Definition.Namespace, revision)
+
+	if revision < 0 {
+		return builder, fmt.Errorf("deployment 'revision' cannot be negative")
+	}
+
+	revisions, err := builder.GetRevisionHistory()
+	if err != nil {
+		return builder, err
+	}
+
+	target, err := findRollbackRevision(revisions, revision)
+	if err != nil {
+		return builder, fmt.Errorf("cannot roll back deployment %s: %w", builder.Definition.Name, err)
+	}
+
+	template := target.ReplicaSet.Object.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+	return builder.updateLatest(func(deployment *appsv1.Deployment) error {
+		deployment.Spec.Template = *template
+
+		return nil
+	})
+}
+
+// GetPods returns the pods of the current revision of the deployment. The pods are found by following the owner
+// references from the deployment to its current replicaset and from the replicaset to its pods, so pods of older
+// revisions that are still terminating are not included.
+func (builder *Builder) GetPods() ([]*pod.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Listing pods of the current revision of deployment %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	revisions, err := builder.GetRevisionHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revisions) == 0 {
+		glog.V(100).Infof("Deployment %s has no replicasets yet", builder.Definition.Name)
+
+		return nil, nil
+	}
+
+	currentRevision := revisions[len(revisions)-1]
+
+	for _, revision := range revisions {
+		if strconv.FormatInt(revision.Number, 10) == builder.Object.Annotations[RevisionAnnotation] {
+			currentRevision = revision
+		}
+	}
+
+	return currentRevision.ReplicaSet.GetPods()
+}
+
+// updateLatest applies the mutation to the latest version of the deployment in the cluster and updates it.
+func (builder *Builder) updateLatest(mutate func(deployment *appsv1.Deployment) error) (*Builder, error) {
+	if !builder.Exists() {
+		return builder, fmt.Errorf("deployment object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if err := mutate(builder.Object); err != nil {
+		return builder, err
+	}
+
+	builder.Definition = builder.Object
+
+	return builder.Update()
+}
+
+// isRolloutComplete reports whether the rollout of the deployment is complete using the same checks as kubectl
+// rollout status.
+func isRolloutComplete(deployment *appsv1.Deployment) (bool, error) {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, nil
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse &&
+			condition.Reason == progressDeadlineExceededReason {
+			return false, fmt.Errorf("deployment %s exceeded its progress deadline: %s",
+				deployment.Name, condition.Message)
+		}
+	}
+
+	desiredReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desiredReplicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.UpdatedReplicas == desiredReplicas &&
+		deployment.Status.Replicas == deployment.Status.UpdatedReplicas &&
+		deployment.Status.AvailableReplicas == deployment.Status.UpdatedReplicas, nil
+}
+
+func findRollbackRevision(revisions []Revision, revision int64) (*Revision, error) {
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision history found")
+	}
+
+	if revision == 0 {
+		if len(revisions) < 2 {
+			return nil, fmt.Errorf("no previous revision found")
+		}
+
+		return &revisions[len(revisions)-2], nil
+	}
+
+	for index := range revisions {
+		if revisions[index].Number == revision {
+			return &revisions[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("revision %d not found", revision)
+}
@@ -0,0 +1,272 @@
+package deployment
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestRolloutRestart(t *testing.T) {
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{buildRolloutTestDeployment(1)})
+
+	testBuilder, err := testBuilder.RolloutRestart()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, testBuilder.Object.Spec.Template.Annotations[RestartedAtAnnotation])
+
+	_, err = buildTestBuilderWithFakeObjects(nil).RolloutRestart()
+	assert.Equal(t, fmt.Errorf("deployment object test-name does not exist in namespace test-namespace"), err)
+}
+
+func TestSetImage(t *testing.T) {
+	testCases := []struct {
+		containerName string
+		image         string
+		expectedError error
+	}{
+		{
+			containerName: "test-container",
+			image:         "test-image:v2",
+		},
+		{
+			containerName: "missing",
+			image:         "test-image:v2",
+			expectedError: fmt.Errorf("container missing does not exist in deployment test-name"),
+		},
+		{
+			containerName: "",
+			image:         "test-image:v2",
+			expectedError: fmt.Errorf("deployment 'containerName' cannot be empty"),
+		},
+		{
+			containerName: "test-container",
+			image:         "",
+			expectedError: fmt.Errorf("deployment 'image' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{buildRolloutTestDeployment(1)})
+
+		testBuilder, err := testBuilder.SetImage(testCase.containerName, testCase.image)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.image, testBuilder.Object.Spec.Template.Spec.Containers[0].Image)
+		}
+	}
+}
+
+func TestScale(t *testing.T) {
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{buildRolloutTestDeployment(3)})
+
+	testBuilder, err := testBuilder.Scale(3, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), *testBuilder.Object.Spec.Replicas)
+
+	_, err = testBuilder.Scale(-1, time.Second)
+	assert.Equal(t, fmt.Errorf("deployment 'replicas' cannot be negative"), err)
+}
+
+func TestIsRolloutComplete(t *testing.T) {
+	testCases := []struct {
+		mutate        func(deployment *appsv1.Deployment)
+		expectedDone  bool
+		expectedError error
+	}{
+		{
+			mutate:       func(deployment *appsv1.Deployment) {},
+			expectedDone: true,
+		},
+		{
+			mutate:       func(deployment *appsv1.Deployment) { deployment.Generation = 2 },
+			expectedDone: false,
+		},
+		{
+			mutate:       func(deployment *appsv1.Deployment) { deployment.Status.Replicas = 3 },
+			expectedDone: false,
+		},
+		{
+			mutate:       func(deployment *appsv1.Deployment) { deployment.Status.AvailableReplicas = 1 },
+			expectedDone: false,
+		},
+		{
+			mutate: func(deployment *appsv1.Deployment) {
+				deployment.Status.Conditions = []appsv1.DeploymentCondition{{
+					Type:    appsv1.DeploymentProgressing,
+					Status:  corev1.ConditionFalse,
+					Reason:  progressDeadlineExceededReason,
+					Message: "ReplicaSet has timed out progressing.",
+				}}
+			},
+			expectedDone: false,
+			expectedError: fmt.Errorf(
+				"deployment test-name exceeded its progress deadline: ReplicaSet has timed out progressing."),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testDeployment := buildRolloutTestDeployment(2)
+		testCase.mutate(testDeployment)
+
+		done, err := isRolloutComplete(testDeployment)
+		assert.Equal(t, testCase.expectedDone, done)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestGetRevisionHistory(t *testing.T) {
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{
+		buildRolloutTestDeployment(1),
+		buildRolloutTestReplicaSet("test-name-b", "2", "test-image:v2"),
+		buildRolloutTestReplicaSet("test-name-a", "1", "test-image:v1"),
+	})
+
+	revisions, err := testBuilder.GetRevisionHistory()
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, int64(1), revisions[0].Number)
+	assert.Equal(t, "test-name-a", revisions[0].ReplicaSet.Object.Name)
+	assert.Equal(t, int64(2), revisions[1].Number)
+}
+
+func TestRollbackTo(t *testing.T) {
+	testCases := []struct {
+		revision      int64
+		expectedImage string
+		expectedError error
+	}{
+		{
+			revision:      0,
+			expectedImage: "test-image:v1",
+		},
+		{
+			revision:      2,
+			expectedImage: "test-image:v2",
+		},
+		{
+			revision:      5,
+			expectedError: fmt.Errorf("cannot roll back deployment test-name: revision 5 not found"),
+		},
+		{
+			revision:      -1,
+			expectedError: fmt.Errorf("deployment 'revision' cannot be negative"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{
+			buildRolloutTestDeployment(1),
+			buildRolloutTestReplicaSet("test-name-a", "1", "test-image:v1"),
+			buildRolloutTestReplicaSet("test-name-b", "2", "test-image:v2"),
+		})
+
+		testBuilder, err := testBuilder.RollbackTo(testCase.revision)
+		if testCase.expectedError != nil {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedImage, testBuilder.Object.Spec.Template.Spec.Containers[0].Image)
+			assert.NotContains(t, testBuilder.Object.Spec.Template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		}
+	}
+}
+
+func buildRolloutTestDeployment(replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-name",
+			Namespace:  "test-namespace",
+			UID:        types.UID("test-uid"),
+			Generation: 1,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"test-key": "test-value"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"test-key": "test-value"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test-container", Image: "test-image:v2"}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           replicas,
+			UpdatedReplicas:    replicas,
+			AvailableReplicas:  replicas,
+			ReadyReplicas:      replicas,
+		},
+	}
+}
+
+func buildRolloutTestReplicaSet(name, revision, image string) *appsv1.ReplicaSet {
+	labels := map[string]string{"test-key": "test-value", appsv1.DefaultDeploymentUniqueLabelKey: name}
+
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "test-namespace",
+			UID:         types.UID(name),
+			Labels:      labels,
+			Annotations: map[string]string{RevisionAnnotation: revision},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "test-name",
+				UID:        types.UID("test-uid"),
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test-container", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func TestDeploymentGetPods(t *testing.T) {
+	testDeployment := buildRolloutTestDeployment(1)
+	testDeployment.Annotations = map[string]string{RevisionAnnotation: "2"}
+
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{
+		testDeployment,
+		buildRolloutTestReplicaSet("test-name-a", "1", "test-image:v1"),
+		buildRolloutTestReplicaSet("test-name-b", "2", "test-image:v2"),
+		buildRolloutTestPod("test-name-a-abcde", "test-name-a"),
+		buildRolloutTestPod("test-name-b-abcde", "test-name-b"),
+	})
+
+	pods, err := testBuilder.GetPods()
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "test-name-b-abcde", pods[0].Object.Name)
+}
+
+func buildRolloutTestPod(name, replicaSetName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"test-key": "test-value", appsv1.DefaultDeploymentUniqueLabelKey: replicaSetName},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       replicaSetName,
+				UID:        types.UID(replicaSetName),
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDeploymentWithSchedulingOptions(t *testing.T) {
+	testBuilder := buildValidTestBuilder().
+		WithNodeAffinity(&corev1.NodeAffinity{}).
+		WithTopologySpreadConstraint(corev1.TopologySpreadConstraint{
+			MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.DoNotSchedule,
+		}).
+		WithPriorityClassName("high-priority").
+		WithRuntimeClassName("performance-test").
+		WithDNSConfig(corev1.DNSClusterFirst, nil).
+		WithHostAlias(corev1.HostAlias{IP: "10.0.0.1", Hostnames: []string{"registry.local"}})
+	assert.Empty(t, testBuilder.errorMsg)
+
+	podSpec := testBuilder.Definition.Spec.Template.Spec
+	assert.NotNil(t, podSpec.Affinity.NodeAffinity)
+	assert.Len(t, podSpec.TopologySpreadConstraints, 1)
+	assert.Equal(t, "high-priority", podSpec.PriorityClassName)
+	assert.Equal(t, "performance-test", *podSpec.RuntimeClassName)
+	assert.Equal(t, corev1.DNSClusterFirst, podSpec.DNSPolicy)
+	assert.Len(t, podSpec.HostAliases, 1)
+
+	testBuilder = buildValidTestBuilder().WithPriorityClassName("")
+	assert.Equal(t, "deployment 'priorityClassName' cannot be empty", testBuilder.errorMsg)
+}
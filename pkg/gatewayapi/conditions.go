@@ -0,0 +1,85 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"time"
+
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var retryInterval = time.Second * 3
+
+// isConditionTrue checks that the condition is true and was observed for at least the given generation. Conditions
+// without an observed generation are accepted as current.
+func isConditionTrue(conditions []metav1.Condition, conditionType string, generation int64) bool {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return false
+	}
+
+	return condition.ObservedGeneration == 0 || condition.ObservedGeneration >= generation
+}
+
+// isRouteAccepted checks that every parent listed in the route status accepted the current generation of the route.
+func isRouteAccepted(status gatewayv1.RouteStatus, generation int64) bool {
+	if len(status.Parents) == 0 {
+		return false
+	}
+
+	for _, parent := range status.Parents {
+		if !isConditionTrue(parent.Conditions, string(gatewayv1.RouteConditionAccepted), generation) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newParentReference returns a reference to the Gateway, restricted to one of its listeners if sectionName is set.
+func newParentReference(gatewayName, gatewayNamespace, sectionName string) gatewayv1.ParentReference {
+	parentRef := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(gatewayName)}
+
+	if gatewayNamespace != "" {
+		namespace := gatewayv1.Namespace(gatewayNamespace)
+		parentRef.Namespace = &namespace
+	}
+
+	if sectionName != "" {
+		section := gatewayv1.SectionName(sectionName)
+		parentRef.SectionName = &section
+	}
+
+	return parentRef
+}
+
+// newServiceBackendRef returns a reference to the port of a Service in the namespace of the route.
+func newServiceBackendRef(serviceName string, port int32) gatewayv1.BackendRef {
+	portNumber := gatewayv1.PortNumber(port)
+
+	return gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name: gatewayv1.ObjectName(serviceName),
+			Port: &portNumber,
+		},
+	}
+}
+
+// validateBackendRef checks that the backend reference has a name and, when set, a valid port.
+func validateBackendRef(backendRef gatewayv1.BackendRef) error {
+	if backendRef.Name == "" {
+		return fmt.Errorf("'name' cannot be empty")
+	}
+
+	if backendRef.Port != nil && !isValidPort(int32(*backendRef.Port)) {
+		return fmt.Errorf("port %d is out of range", *backendRef.Port)
+	}
+
+	return nil
+}
+
+// isValidPort checks that the port is in the range allowed by the API.
+func isValidPort(port int32) bool {
+	return port >= 1 && port <= 65535
+}
@@ -0,0 +1,531 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GatewayBuilder provides a struct for gateway object from the cluster and a gateway definition.
+type GatewayBuilder struct {
+	// gateway definition, used to create the gateway object.
+	Definition *gatewayv1.Gateway
+	// Created gateway object.
+	Object *gatewayv1.Gateway
+	// Used to store latest error message upon defining or mutating gateway definition.
+	errorMsg string
+	// api client to interact with the cluster.
+	apiClient goclient.Client
+}
+
+// GatewayAdditionalOptions additional options for gateway object.
+type GatewayAdditionalOptions func(builder *GatewayBuilder) (*GatewayBuilder, error)
+
+// NewGatewayBuilder creates a new instance of GatewayBuilder implemented by the given gatewayClass. Listeners are
+// added with WithListener, WithHTTPListener or WithHTTPSListener.
+func NewGatewayBuilder(apiClient *clients.Settings, name, nsname, gatewayClassName string) *GatewayBuilder {
+	glog.V(100).Infof(
+		"Initializing new gateway structure with the following params: name: %s, namespace: %s, gatewayClassName: %s",
+		name, nsname, gatewayClassName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("gateway 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil
+	}
+
+	builder := &GatewayBuilder{
+		apiClient: apiClient.Client,
+		Definition: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: gatewayv1.ObjectName(gatewayClassName),
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the gateway is empty")
+
+		builder.errorMsg = "gateway 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the gateway is empty")
+
+		builder.errorMsg = "gateway 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if gatewayClassName == "" {
+		glog.V(100).Infof("The gatewayClassName of the gateway is empty")
+
+		builder.errorMsg = "gateway 'gatewayClassName' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// PullGateway pulls existing gateway from cluster.
+func PullGateway(apiClient *clients.Settings, name, nsname string) (*GatewayBuilder, error) {
+	glog.V(100).Infof("Pulling existing gateway name %s in namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("gateway 'apiClient' cannot be empty")
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	builder := &GatewayBuilder{
+		apiClient: apiClient.Client,
+		Definition: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the gateway is empty")
+
+		return nil, fmt.Errorf("gateway 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the gateway is empty")
+
+		return nil, fmt.Errorf("gateway 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("gateway object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithListener appends a listener to the gateway. Listener names must be unique and TLS terminating listeners must
+// reference at least one certificate.
+func (builder *GatewayBuilder) WithListener(listener gatewayv1.Listener) *GatewayBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding listener %s to gateway %s in namespace %s",
+		listener.Name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.validateListener(listener); err != nil {
+		builder.errorMsg = fmt.Sprintf("gateway listener is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.Listeners = append(builder.Definition.Spec.Listeners, listener)
+
+	return builder
+}
+
+// WithHTTPListener appends a cleartext HTTP listener to the gateway. An empty hostname matches all the hosts.
+func (builder *GatewayBuilder) WithHTTPListener(name string, port int32, hostname string) *GatewayBuilder {
+	return builder.WithListener(newListener(name, port, hostname, gatewayv1.HTTPProtocolType))
+}
+
+// WithHTTPSListener appends an HTTPS listener terminating TLS with the certificate stored in the secret, which must
+// be in the namespace of the gateway.
+func (builder *GatewayBuilder) WithHTTPSListener(
+	name string, port int32, hostname, certificateSecretName string) *GatewayBuilder {
+	listener := newListener(name, port, hostname, gatewayv1.HTTPSProtocolType)
+	mode := gatewayv1.TLSModeTerminate
+	listener.TLS = &gatewayv1.GatewayTLSConfig{Mode: &mode}
+
+	if certificateSecretName != "" {
+		listener.TLS.CertificateRefs = []gatewayv1.SecretObjectReference{
+			{Name: gatewayv1.ObjectName(certificateSecretName)},
+		}
+	}
+
+	return builder.WithListener(listener)
+}
+
+// WithAddress requests a specific address for the gateway.
+func (builder *GatewayBuilder) WithAddress(addressType gatewayv1.AddressType, value string) *GatewayBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding address %s of type %s to gateway %s in namespace %s",
+		value, addressType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if value == "" {
+		builder.errorMsg = "gateway address 'value' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Addresses = append(builder.Definition.Spec.Addresses, gatewayv1.GatewayAddress{
+		Type:  &addressType,
+		Value: value,
+	})
+
+	return builder
+}
+
+// WithOptions creates gateway with generic mutation options.
+func (builder *GatewayBuilder) WithOptions(options ...GatewayAdditionalOptions) *GatewayBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting gateway additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Get fetches the defined gateway from the cluster.
+func (builder *GatewayBuilder) Get() (*gatewayv1.Gateway, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting gateway %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	gateway := &gatewayv1.Gateway{}
+
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	return gateway, nil
+}
+
+// Exists checks whether the given gateway exists.
+func (builder *GatewayBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if gateway %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a gateway in the cluster and stores the created object in struct.
+func (builder *GatewayBuilder) Create() (*GatewayBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating the gateway %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.Listeners) == 0 {
+		return builder, fmt.Errorf("gateway %s must have at least one listener", builder.Definition.Name)
+	}
+
+	var err error
+	if !builder.Exists() {
+		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+
+		if err == nil {
+			builder.Object = builder.Definition
+		}
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing gateway object with the gateway definition in builder.
+func (builder *GatewayBuilder) Update() (*GatewayBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the gateway %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("gateway object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, fmt.Errorf("cannot update gateway: %w", err)
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Delete removes the gateway from the cluster.
+func (builder *GatewayBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting the gateway %s from namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	if err != nil {
+		return fmt.Errorf("cannot delete gateway: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsAccepted checks whether the controller of the gatewayClass accepted the current generation of the gateway.
+func (builder *GatewayBuilder) IsAccepted() (bool, error) {
+	return builder.isConditionTrue(gatewayv1.GatewayConditionAccepted)
+}
+
+// IsProgrammed checks whether the current generation of the gateway was programmed into the data plane.
+func (builder *GatewayBuilder) IsProgrammed() (bool, error) {
+	return builder.isConditionTrue(gatewayv1.GatewayConditionProgrammed)
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until the gateway is accepted.
+func (builder *GatewayBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	return builder.waitUntilConditionTrue(gatewayv1.GatewayConditionAccepted, timeout)
+}
+
+// WaitUntilProgrammed waits for the duration of the defined timeout or until the gateway is programmed.
+func (builder *GatewayBuilder) WaitUntilProgrammed(timeout time.Duration) error {
+	return builder.waitUntilConditionTrue(gatewayv1.GatewayConditionProgrammed, timeout)
+}
+
+// GetAddresses returns the addresses bound to the gateway, as resolved by its controller. The listeners of the
+// gateway are reachable on these addresses.
+func (builder *GatewayBuilder) GetAddresses() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting addresses of gateway %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("gateway object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var addresses []string
+
+	for _, address := range builder.Object.Status.Addresses {
+		addresses = append(addresses, address.Value)
+	}
+
+	return addresses, nil
+}
+
+// GetListenerStatus returns the status of the listener with the given name.
+func (builder *GatewayBuilder) GetListenerStatus(listenerName string) (*gatewayv1.ListenerStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting status of listener %s of gateway %s in namespace %s",
+		listenerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("gateway object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for index := range builder.Object.Status.Listeners {
+		if string(builder.Object.Status.Listeners[index].Name) == listenerName {
+			return &builder.Object.Status.Listeners[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("gateway %s has no status for listener %s", builder.Definition.Name, listenerName)
+}
+
+func (builder *GatewayBuilder) isConditionTrue(conditionType gatewayv1.GatewayConditionType) (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if gateway %s in namespace %s has condition %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("gateway object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return isConditionTrue(builder.Object.Status.Conditions, string(conditionType), builder.Object.Generation), nil
+}
+
+func (builder *GatewayBuilder) waitUntilConditionTrue(
+	conditionType gatewayv1.GatewayConditionType, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until gateway %s in namespace %s has condition %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for gateway %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			conditionTrue, err := builder.isConditionTrue(conditionType)
+			if err != nil {
+				return false, nil
+			}
+
+			return conditionTrue, nil
+		})
+}
+
+func (builder *GatewayBuilder) validateListener(listener gatewayv1.Listener) error {
+	if listener.Name == "" {
+		return fmt.Errorf("'name' cannot be empty")
+	}
+
+	for _, existingListener := range builder.Definition.Spec.Listeners {
+		if existingListener.Name == listener.Name {
+			return fmt.Errorf("listener %s already exists", listener.Name)
+		}
+	}
+
+	if !isValidPort(int32(listener.Port)) {
+		return fmt.Errorf("port %d is out of range", listener.Port)
+	}
+
+	switch listener.Protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.TCPProtocolType, gatewayv1.UDPProtocolType:
+	case gatewayv1.HTTPSProtocolType, gatewayv1.TLSProtocolType:
+		if listener.TLS == nil {
+			return fmt.Errorf("protocol %s requires a TLS configuration", listener.Protocol)
+		}
+
+		terminate := listener.TLS.Mode == nil || *listener.TLS.Mode == gatewayv1.TLSModeTerminate
+		if terminate && len(listener.TLS.CertificateRefs) == 0 {
+			return fmt.Errorf("TLS termination requires at least one certificate reference")
+		}
+	default:
+		return fmt.Errorf("protocol %s is not supported", listener.Protocol)
+	}
+
+	return nil
+}
+
+// newListener returns a listener of the protocol on the port, restricted to the hostname when it is not empty.
+func newListener(name string, port int32, hostname string, protocol gatewayv1.ProtocolType) gatewayv1.Listener {
+	listener := gatewayv1.Listener{
+		Name:     gatewayv1.SectionName(name),
+		Port:     gatewayv1.PortNumber(port),
+		Protocol: protocol,
+	}
+
+	if hostname != "" {
+		listenerHostname := gatewayv1.Hostname(hostname)
+		listener.Hostname = &listenerHostname
+	}
+
+	return listener
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *GatewayBuilder) validate() (bool, error) {
+	resourceCRD := "Gateway"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,844 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultGatewayName      = "test-gateway"
+	defaultGatewayNamespace = "test-namespace"
+	defaultGatewayAddress   = "192.168.10.1"
+)
+
+func TestNewGatewayBuilder(t *testing.T) {
+	testCases := []struct {
+		name             string
+		namespace        string
+		gatewayClassName string
+		client           bool
+		expectedError    string
+	}{
+		{
+			name:             defaultGatewayName,
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: defaultGatewayClassName,
+			client:           true,
+			expectedError:    "",
+		},
+		{
+			name:             "",
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: defaultGatewayClassName,
+			client:           true,
+			expectedError:    "gateway 'name' cannot be empty",
+		},
+		{
+			name:             defaultGatewayName,
+			namespace:        "",
+			gatewayClassName: defaultGatewayClassName,
+			client:           true,
+			expectedError:    "gateway 'nsname' cannot be empty",
+		},
+		{
+			name:             defaultGatewayName,
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: "",
+			client:           true,
+			expectedError:    "gateway 'gatewayClassName' cannot be empty",
+		},
+		{
+			name:             defaultGatewayName,
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: defaultGatewayClassName,
+			client:           false,
+			expectedError:    "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+		}
+
+		testBuilder := NewGatewayBuilder(testSettings, testCase.name, testCase.namespace, testCase.gatewayClassName)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, gatewayv1.ObjectName(testCase.gatewayClassName),
+				testBuilder.Definition.Spec.GatewayClassName)
+		}
+	}
+}
+
+func TestPullGateway(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultGatewayName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultGatewayName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("gateway object test-gateway does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("gateway 'name' cannot be empty"),
+		},
+		{
+			name:                defaultGatewayName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("gateway 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultGatewayName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("gateway 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyGateway()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		testBuilder, err := PullGateway(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestGatewayWithListener(t *testing.T) {
+	testCases := []struct {
+		listener      gatewayv1.Listener
+		expectedError string
+	}{
+		{
+			listener:      gatewayv1.Listener{Name: "tcp", Port: 9000, Protocol: gatewayv1.TCPProtocolType},
+			expectedError: "",
+		},
+		{
+			listener:      gatewayv1.Listener{Name: "", Port: 9000, Protocol: gatewayv1.TCPProtocolType},
+			expectedError: "gateway listener is invalid: 'name' cannot be empty",
+		},
+		{
+			listener:      gatewayv1.Listener{Name: "http", Port: 8080, Protocol: gatewayv1.HTTPProtocolType},
+			expectedError: "gateway listener is invalid: listener http already exists",
+		},
+		{
+			listener:      gatewayv1.Listener{Name: "tcp", Port: 0, Protocol: gatewayv1.TCPProtocolType},
+			expectedError: "gateway listener is invalid: port 0 is out of range",
+		},
+		{
+			listener:      gatewayv1.Listener{Name: "tls", Port: 443, Protocol: gatewayv1.TLSProtocolType},
+			expectedError: "gateway listener is invalid: protocol TLS requires a TLS configuration",
+		},
+		{
+			listener:      gatewayv1.Listener{Name: "sctp", Port: 9999, Protocol: "SCTP"},
+			expectedError: "gateway listener is invalid: protocol SCTP is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(buildGatewayClientWithDummyObject()).
+			WithListener(testCase.listener)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Len(t, testBuilder.Definition.Spec.Listeners, 2)
+			assert.Equal(t, testCase.listener, testBuilder.Definition.Spec.Listeners[1])
+		}
+	}
+}
+
+func TestGatewayWithHTTPListener(t *testing.T) {
+	testCases := []struct {
+		name          string
+		port          int32
+		hostname      string
+		expectedError string
+	}{
+		{
+			name:          "http-alt",
+			port:          8080,
+			hostname:      "*.example.com",
+			expectedError: "",
+		},
+		{
+			name:          "http-alt",
+			port:          8080,
+			hostname:      "",
+			expectedError: "",
+		},
+		{
+			name:          "http",
+			port:          8080,
+			hostname:      "",
+			expectedError: "gateway listener is invalid: listener http already exists",
+		},
+		{
+			name:          "http-alt",
+			port:          70000,
+			hostname:      "",
+			expectedError: "gateway listener is invalid: port 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(buildGatewayClientWithDummyObject()).
+			WithHTTPListener(testCase.name, testCase.port, testCase.hostname)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			listener := testBuilder.Definition.Spec.Listeners[1]
+			assert.Equal(t, gatewayv1.HTTPProtocolType, listener.Protocol)
+			assert.Equal(t, gatewayv1.PortNumber(testCase.port), listener.Port)
+
+			if testCase.hostname == "" {
+				assert.Nil(t, listener.Hostname)
+			} else {
+				assert.Equal(t, gatewayv1.Hostname(testCase.hostname), *listener.Hostname)
+			}
+		}
+	}
+}
+
+func TestGatewayWithHTTPSListener(t *testing.T) {
+	testCases := []struct {
+		name          string
+		port          int32
+		secretName    string
+		expectedError string
+	}{
+		{
+			name:          "https",
+			port:          443,
+			secretName:    "test-cert",
+			expectedError: "",
+		},
+		{
+			name:          "https",
+			port:          443,
+			secretName:    "",
+			expectedError: "gateway listener is invalid: TLS termination requires at least one certificate reference",
+		},
+		{
+			name:          "http",
+			port:          443,
+			secretName:    "test-cert",
+			expectedError: "gateway listener is invalid: listener http already exists",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(buildGatewayClientWithDummyObject()).
+			WithHTTPSListener(testCase.name, testCase.port, "*.example.com", testCase.secretName)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			listener := testBuilder.Definition.Spec.Listeners[1]
+			assert.Equal(t, gatewayv1.HTTPSProtocolType, listener.Protocol)
+			assert.Equal(t, gatewayv1.ObjectName(testCase.secretName), listener.TLS.CertificateRefs[0].Name)
+		}
+	}
+}
+
+func TestGatewayWithAddress(t *testing.T) {
+	testCases := []struct {
+		addressType   gatewayv1.AddressType
+		value         string
+		expectedError string
+	}{
+		{
+			addressType:   gatewayv1.IPAddressType,
+			value:         defaultGatewayAddress,
+			expectedError: "",
+		},
+		{
+			addressType:   gatewayv1.HostnameAddressType,
+			value:         "gateway.example.com",
+			expectedError: "",
+		},
+		{
+			addressType:   gatewayv1.IPAddressType,
+			value:         "",
+			expectedError: "gateway address 'value' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(buildGatewayClientWithDummyObject()).
+			WithAddress(testCase.addressType, testCase.value)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.value, testBuilder.Definition.Spec.Addresses[0].Value)
+			assert.Equal(t, testCase.addressType, *testBuilder.Definition.Spec.Addresses[0].Type)
+		}
+	}
+}
+
+func TestGatewayWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    GatewayAdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *GatewayBuilder) (*GatewayBuilder, error) {
+				builder.Definition.Labels = map[string]string{"app": "test"}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *GatewayBuilder) (*GatewayBuilder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(buildGatewayClientWithDummyObject()).WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+		}
+	}
+}
+
+func TestGatewayExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *GatewayBuilder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder: buildValidGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestGatewayCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: NewGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}),
+				defaultGatewayName, defaultGatewayNamespace, defaultGatewayClassName),
+			expectedError: fmt.Errorf("gateway test-gateway must have at least one listener"),
+		},
+		{
+			testBuilder:   buildInvalidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: fmt.Errorf("gateway 'gatewayClassName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestGatewayUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf("gateway object test-gateway does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: fmt.Errorf("gateway 'gatewayClassName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithAddress(gatewayv1.IPAddressType, defaultGatewayAddress).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultGatewayAddress, testBuilder.Object.Spec.Addresses[0].Value)
+		}
+	}
+}
+
+func TestGatewayDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: fmt.Errorf("gateway 'gatewayClassName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestGatewayIsAccepted(t *testing.T) {
+	testCases := []struct {
+		acceptedStatus   metav1.ConditionStatus
+		exists           bool
+		expectedAccepted bool
+		expectedError    error
+	}{
+		{
+			acceptedStatus:   metav1.ConditionTrue,
+			exists:           true,
+			expectedAccepted: true,
+			expectedError:    nil,
+		},
+		{
+			acceptedStatus:   metav1.ConditionFalse,
+			exists:           true,
+			expectedAccepted: false,
+			expectedError:    nil,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("gateway object test-gateway does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyGatewayWithStatus(testCase.acceptedStatus, metav1.ConditionTrue))
+		}
+
+		testBuilder := buildValidGatewayBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		accepted, err := testBuilder.IsAccepted()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAccepted, accepted)
+	}
+}
+
+func TestGatewayIsProgrammed(t *testing.T) {
+	testCases := []struct {
+		programmedStatus   metav1.ConditionStatus
+		exists             bool
+		expectedProgrammed bool
+		expectedError      error
+	}{
+		{
+			programmedStatus:   metav1.ConditionTrue,
+			exists:             true,
+			expectedProgrammed: true,
+			expectedError:      nil,
+		},
+		{
+			programmedStatus:   metav1.ConditionFalse,
+			exists:             true,
+			expectedProgrammed: false,
+			expectedError:      nil,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("gateway object test-gateway does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyGatewayWithStatus(metav1.ConditionTrue, testCase.programmedStatus))
+		}
+
+		testBuilder := buildValidGatewayBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		programmed, err := testBuilder.IsProgrammed()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedProgrammed, programmed)
+	}
+}
+
+func TestGatewayWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		acceptedStatus metav1.ConditionStatus
+		exists         bool
+		expectedError  error
+	}{
+		{
+			acceptedStatus: metav1.ConditionTrue,
+			exists:         true,
+			expectedError:  nil,
+		},
+		{
+			acceptedStatus: metav1.ConditionFalse,
+			exists:         true,
+			expectedError:  fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			exists: false,
+			expectedError: fmt.Errorf(
+				"cannot wait for gateway test-gateway which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyGatewayWithStatus(testCase.acceptedStatus, metav1.ConditionTrue))
+		}
+
+		testBuilder := buildValidGatewayBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		err := testBuilder.WaitUntilAccepted(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestGatewayWaitUntilProgrammed(t *testing.T) {
+	testCases := []struct {
+		programmedStatus metav1.ConditionStatus
+		exists           bool
+		expectedError    error
+	}{
+		{
+			programmedStatus: metav1.ConditionTrue,
+			exists:           true,
+			expectedError:    nil,
+		},
+		{
+			programmedStatus: metav1.ConditionFalse,
+			exists:           true,
+			expectedError:    fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			exists: false,
+			expectedError: fmt.Errorf(
+				"cannot wait for gateway test-gateway which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyGatewayWithStatus(metav1.ConditionTrue, testCase.programmedStatus))
+		}
+
+		testBuilder := buildValidGatewayBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		err := testBuilder.WaitUntilProgrammed(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestGatewayGetAddresses(t *testing.T) {
+	testCases := []struct {
+		testBuilder       *GatewayBuilder
+		expectedAddresses []string
+		expectedError     error
+	}{
+		{
+			testBuilder:       buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedAddresses: []string{defaultGatewayAddress},
+			expectedError:     nil,
+		},
+		{
+			testBuilder: buildValidGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf("gateway object test-gateway does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			expectedError: fmt.Errorf("gateway 'gatewayClassName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		addresses, err := testCase.testBuilder.GetAddresses()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAddresses, addresses)
+	}
+}
+
+func TestGatewayGetListenerStatus(t *testing.T) {
+	testCases := []struct {
+		testBuilder            *GatewayBuilder
+		listenerName           string
+		expectedAttachedRoutes int32
+		expectedError          error
+	}{
+		{
+			testBuilder:            buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			listenerName:           "http",
+			expectedAttachedRoutes: 1,
+			expectedError:          nil,
+		},
+		{
+			testBuilder:   buildValidGatewayBuilder(buildGatewayClientWithDummyObject()),
+			listenerName:  "https",
+			expectedError: fmt.Errorf("gateway test-gateway has no status for listener https"),
+		},
+		{
+			testBuilder: buildValidGatewayBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			listenerName:  "http",
+			expectedError: fmt.Errorf("gateway object test-gateway does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		listenerStatus, err := testCase.testBuilder.GetListenerStatus(testCase.listenerName)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedAttachedRoutes, listenerStatus.AttachedRoutes)
+		}
+	}
+}
+
+func TestGatewayValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil Gateway builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined Gateway",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "Gateway builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(
+			clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidGatewayBuilder(apiClient *clients.Settings) *GatewayBuilder {
+	return NewGatewayBuilder(apiClient, defaultGatewayName, defaultGatewayNamespace, defaultGatewayClassName).
+		WithHTTPListener("http", 80, "")
+}
+
+func buildInvalidGatewayBuilder(apiClient *clients.Settings) *GatewayBuilder {
+	return NewGatewayBuilder(apiClient, defaultGatewayName, defaultGatewayNamespace, "")
+}
+
+func buildGatewayClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyGateway(),
+		SchemeAttachers: testSchemes,
+	})
+}
+
+func buildDummyGateway() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyGatewayWithStatus(metav1.ConditionTrue, metav1.ConditionTrue))
+}
+
+func buildDummyGatewayWithStatus(acceptedStatus, programmedStatus metav1.ConditionStatus) *gatewayv1.Gateway {
+	addressType := gatewayv1.IPAddressType
+
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       defaultGatewayName,
+			Namespace:  defaultGatewayNamespace,
+			Generation: 2,
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: gatewayv1.ObjectName(defaultGatewayClassName),
+			Listeners:        []gatewayv1.Listener{{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType}},
+		},
+		Status: gatewayv1.GatewayStatus{
+			Addresses: []gatewayv1.GatewayStatusAddress{{Type: &addressType, Value: defaultGatewayAddress}},
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gatewayv1.GatewayConditionAccepted),
+					Status:             acceptedStatus,
+					ObservedGeneration: 2,
+				},
+				{
+					Type:               string(gatewayv1.GatewayConditionProgrammed),
+					Status:             programmedStatus,
+					ObservedGeneration: 2,
+				},
+			},
+			Listeners: []gatewayv1.ListenerStatus{{Name: "http", AttachedRoutes: 1}},
+		},
+	}
+}
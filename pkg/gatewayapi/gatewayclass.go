@@ -0,0 +1,332 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GatewayClassBuilder provides a struct for gatewayClass object from the cluster and a gatewayClass definition.
+type GatewayClassBuilder struct {
+	// gatewayClass definition, used to create the gatewayClass object.
+	Definition *gatewayv1.GatewayClass
+	// Created gatewayClass object.
+	Object *gatewayv1.GatewayClass
+	// Used to store latest error message upon defining or mutating gatewayClass definition.
+	errorMsg string
+	// api client to interact with the cluster.
+	apiClient goclient.Client
+}
+
+// GatewayClassAdditionalOptions additional options for gatewayClass object.
+type GatewayClassAdditionalOptions func(builder *GatewayClassBuilder) (*GatewayClassBuilder, error)
+
+// NewGatewayClassBuilder creates a new instance of GatewayClassBuilder managed by the given controller, for example
+// openshift.io/gateway-controller/v1 on OpenShift.
+func NewGatewayClassBuilder(apiClient *clients.Settings, name, controllerName string) *GatewayClassBuilder {
+	glog.V(100).Infof(
+		"Initializing new gatewayClass structure with the following params: name: %s, controllerName: %s",
+		name, controllerName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("gatewayClass 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil
+	}
+
+	builder := &GatewayClassBuilder{
+		apiClient: apiClient.Client,
+		Definition: &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: gatewayv1.GatewayController(controllerName),
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the gatewayClass is empty")
+
+		builder.errorMsg = "gatewayClass 'name' cannot be empty"
+
+		return builder
+	}
+
+	if controllerName == "" {
+		glog.V(100).Infof("The controllerName of the gatewayClass is empty")
+
+		builder.errorMsg = "gatewayClass 'controllerName' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// PullGatewayClass pulls existing gatewayClass from cluster.
+func PullGatewayClass(apiClient *clients.Settings, name string) (*GatewayClassBuilder, error) {
+	glog.V(100).Infof("Pulling existing gatewayClass name %s from cluster", name)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("gatewayClass 'apiClient' cannot be empty")
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	builder := &GatewayClassBuilder{
+		apiClient: apiClient.Client,
+		Definition: &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the gatewayClass is empty")
+
+		return nil, fmt.Errorf("gatewayClass 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("gatewayClass object %s does not exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithDescription sets the description of the gatewayClass.
+func (builder *GatewayClassBuilder) WithDescription(description string) *GatewayClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting description %s in gatewayClass %s", description, builder.Definition.Name)
+
+	builder.Definition.Spec.Description = &description
+
+	return builder
+}
+
+// WithOptions creates gatewayClass with generic mutation options.
+func (builder *GatewayClassBuilder) WithOptions(options ...GatewayClassAdditionalOptions) *GatewayClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting gatewayClass additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Get fetches the defined gatewayClass from the cluster.
+func (builder *GatewayClassBuilder) Get() (*gatewayv1.GatewayClass, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting gatewayClass %s", builder.Definition.Name)
+
+	gatewayClass := &gatewayv1.GatewayClass{}
+
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{Name: builder.Definition.Name}, gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+
+	return gatewayClass, nil
+}
+
+// Exists checks whether the given gatewayClass exists.
+func (builder *GatewayClassBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if gatewayClass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a gatewayClass in the cluster and stores the created object in struct.
+func (builder *GatewayClassBuilder) Create() (*GatewayClassBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating the gatewayClass %s", builder.Definition.Name)
+
+	var err error
+	if !builder.Exists() {
+		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+
+		if err == nil {
+			builder.Object = builder.Definition
+		}
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing gatewayClass object with the gatewayClass definition in builder.
+func (builder *GatewayClassBuilder) Update() (*GatewayClassBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the gatewayClass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("gatewayClass object %s does not exist", builder.Definition.Name)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, fmt.Errorf("cannot update gatewayClass: %w", err)
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Delete removes the gatewayClass from the cluster.
+func (builder *GatewayClassBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting the gatewayClass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	if err != nil {
+		return fmt.Errorf("cannot delete gatewayClass: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsAccepted checks whether the controller named in the gatewayClass accepted it.
+func (builder *GatewayClassBuilder) IsAccepted() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if gatewayClass %s is accepted", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("gatewayClass object %s does not exist", builder.Definition.Name)
+	}
+
+	return isConditionTrue(builder.Object.Status.Conditions,
+		string(gatewayv1.GatewayClassConditionStatusAccepted), builder.Object.Generation), nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until the gatewayClass is accepted.
+func (builder *GatewayClassBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until gatewayClass %s is accepted", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for gatewayClass %s which does not exist", builder.Definition.Name)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			accepted, err := builder.IsAccepted()
+			if err != nil {
+				return false, nil
+			}
+
+			return accepted, nil
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *GatewayClassBuilder) validate() (bool, error) {
+	resourceCRD := "GatewayClass"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
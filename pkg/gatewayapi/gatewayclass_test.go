@@ -0,0 +1,498 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultGatewayClassName = "test-gatewayclass"
+	defaultControllerName   = "openshift.io/gateway-controller/v1"
+	testSchemes             = []clients.SchemeAttacher{
+		gatewayv1.AddToScheme,
+	}
+)
+
+func TestNewGatewayClassBuilder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		controllerName string
+		client         bool
+		expectedError  string
+	}{
+		{
+			name:           defaultGatewayClassName,
+			controllerName: defaultControllerName,
+			client:         true,
+			expectedError:  "",
+		},
+		{
+			name:           "",
+			controllerName: defaultControllerName,
+			client:         true,
+			expectedError:  "gatewayClass 'name' cannot be empty",
+		},
+		{
+			name:           defaultGatewayClassName,
+			controllerName: "",
+			client:         true,
+			expectedError:  "gatewayClass 'controllerName' cannot be empty",
+		},
+		{
+			name:           defaultGatewayClassName,
+			controllerName: defaultControllerName,
+			client:         false,
+			expectedError:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+		}
+
+		testBuilder := NewGatewayClassBuilder(testSettings, testCase.name, testCase.controllerName)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, gatewayv1.GatewayController(testCase.controllerName),
+				testBuilder.Definition.Spec.ControllerName)
+		}
+	}
+}
+
+func TestPullGatewayClass(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultGatewayClassName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultGatewayClassName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("gatewayClass object test-gatewayclass does not exist"),
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("gatewayClass 'name' cannot be empty"),
+		},
+		{
+			name:                defaultGatewayClassName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("gatewayClass 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyGatewayClass()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		testBuilder, err := PullGatewayClass(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestGatewayClassWithDescription(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayClassBuilder
+		description   string
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			description:   "test description",
+			expectedError: "",
+		},
+		{
+			testBuilder:   buildInvalidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			description:   "test description",
+			expectedError: "gatewayClass 'controllerName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithDescription(testCase.description)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.description, *testBuilder.Definition.Spec.Description)
+		}
+	}
+}
+
+func TestGatewayClassWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    GatewayClassAdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *GatewayClassBuilder) (*GatewayClassBuilder, error) {
+				builder.Definition.Labels = map[string]string{"app": "test"}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *GatewayClassBuilder) (*GatewayClassBuilder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+		}
+	}
+}
+
+func TestGatewayClassExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *GatewayClassBuilder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder: buildValidGatewayClassBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestGatewayClassCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayClassBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidGatewayClassBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("gatewayClass 'controllerName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestGatewayClassUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayClassBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGatewayClassBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf("gatewayClass object test-gatewayclass does not exist"),
+		},
+		{
+			testBuilder:   buildInvalidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("gatewayClass 'controllerName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithDescription("test description").Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, "test description", *testBuilder.Object.Spec.Description)
+		}
+	}
+}
+
+func TestGatewayClassDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GatewayClassBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGatewayClassBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidGatewayClassBuilder(buildGatewayClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("gatewayClass 'controllerName' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestGatewayClassIsAccepted(t *testing.T) {
+	testCases := []struct {
+		acceptedStatus   metav1.ConditionStatus
+		exists           bool
+		expectedAccepted bool
+		expectedError    error
+	}{
+		{
+			acceptedStatus:   metav1.ConditionTrue,
+			exists:           true,
+			expectedAccepted: true,
+			expectedError:    nil,
+		},
+		{
+			acceptedStatus:   metav1.ConditionUnknown,
+			exists:           true,
+			expectedAccepted: false,
+			expectedError:    nil,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("gatewayClass object test-gatewayclass does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyGatewayClassWithStatus(testCase.acceptedStatus))
+		}
+
+		testBuilder := buildValidGatewayClassBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		accepted, err := testBuilder.IsAccepted()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAccepted, accepted)
+	}
+}
+
+func TestGatewayClassWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		acceptedStatus metav1.ConditionStatus
+		exists         bool
+		expectedError  error
+	}{
+		{
+			acceptedStatus: metav1.ConditionTrue,
+			exists:         true,
+			expectedError:  nil,
+		},
+		{
+			acceptedStatus: metav1.ConditionUnknown,
+			exists:         true,
+			expectedError:  fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("cannot wait for gatewayClass test-gatewayclass which does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyGatewayClassWithStatus(testCase.acceptedStatus))
+		}
+
+		testBuilder := buildValidGatewayClassBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		err := testBuilder.WaitUntilAccepted(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestGatewayClassValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil GatewayClass builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined GatewayClass",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "GatewayClass builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayClassBuilder(
+			clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidGatewayClassBuilder(apiClient *clients.Settings) *GatewayClassBuilder {
+	return NewGatewayClassBuilder(apiClient, defaultGatewayClassName, defaultControllerName)
+}
+
+func buildInvalidGatewayClassBuilder(apiClient *clients.Settings) *GatewayClassBuilder {
+	return NewGatewayClassBuilder(apiClient, defaultGatewayClassName, "")
+}
+
+func buildGatewayClassClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyGatewayClass(),
+		SchemeAttachers: testSchemes,
+	})
+}
+
+func buildDummyGatewayClass() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyGatewayClassWithStatus(metav1.ConditionTrue))
+}
+
+func buildDummyGatewayClassWithStatus(acceptedStatus metav1.ConditionStatus) *gatewayv1.GatewayClass {
+	return &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultGatewayClassName,
+		},
+		Spec: gatewayv1.GatewayClassSpec{
+			ControllerName: gatewayv1.GatewayController(defaultControllerName),
+		},
+		Status: gatewayv1.GatewayClassStatus{
+			Conditions: []metav1.Condition{{
+				Type:   string(gatewayv1.GatewayClassConditionStatusAccepted),
+				Status: acceptedStatus,
+			}},
+		},
+	}
+}
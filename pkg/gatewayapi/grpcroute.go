@@ -0,0 +1,321 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const grpcRouteKind = "grpcRoute"
+
+// GRPCRouteBuilder provides a struct for grpcRoute object from the cluster and a grpcRoute definition.
+type GRPCRouteBuilder struct {
+	// grpcRoute definition, used to create the grpcRoute object.
+	Definition *gatewayv1.GRPCRoute
+	// Created grpcRoute object.
+	Object *gatewayv1.GRPCRoute
+	// Used to store latest error message upon defining or mutating grpcRoute definition.
+	errorMsg string
+	// api client to interact with the cluster.
+	apiClient goclient.Client
+}
+
+// GRPCRouteAdditionalOptions additional options for grpcRoute object.
+type GRPCRouteAdditionalOptions func(builder *GRPCRouteBuilder) (*GRPCRouteBuilder, error)
+
+// NewGRPCRouteBuilder creates a new instance of GRPCRouteBuilder. The route is attached to gateways with
+// WithParentRef.
+func NewGRPCRouteBuilder(apiClient *clients.Settings, name, nsname string) *GRPCRouteBuilder {
+	glog.V(100).Infof(
+		"Initializing new grpcRoute structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("grpcRoute 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil
+	}
+
+	definition, errorMsg := newRouteDefinition[gatewayv1.GRPCRoute](grpcRouteKind, name, nsname)
+
+	return &GRPCRouteBuilder{
+		apiClient:  apiClient.Client,
+		Definition: definition,
+		errorMsg:   errorMsg,
+	}
+}
+
+// PullGRPCRoute pulls existing grpcRoute from cluster.
+func PullGRPCRoute(apiClient *clients.Settings, name, nsname string) (*GRPCRouteBuilder, error) {
+	object, err := pullRoute[gatewayv1.GRPCRoute](apiClient, grpcRouteKind, name, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GRPCRouteBuilder{
+		apiClient:  apiClient.Client,
+		Definition: object,
+		Object:     object,
+	}, nil
+}
+
+// WithParentRef attaches the grpcRoute to the gateway. An empty gatewayNamespace refers to the namespace of the
+// route and an empty sectionName attaches the route to all the listeners of the gateway.
+func (builder *GRPCRouteBuilder) WithParentRef(gatewayName, gatewayNamespace, sectionName string) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding parentRef to gateway %s in namespace %s section %s to grpcRoute %s in namespace %s",
+		gatewayName, gatewayNamespace, sectionName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if gatewayName == "" {
+		builder.errorMsg = "grpcRoute parentRef 'gatewayName' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ParentRefs = append(builder.Definition.Spec.ParentRefs,
+		newParentReference(gatewayName, gatewayNamespace, sectionName))
+
+	return builder
+}
+
+// WithHostnames sets the hostnames matched against the authority of the gRPC calls.
+func (builder *GRPCRouteBuilder) WithHostnames(hostnames ...string) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting hostnames %v in grpcRoute %s in namespace %s",
+		hostnames, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(hostnames) == 0 {
+		builder.errorMsg = "grpcRoute 'hostnames' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Hostnames = newHostnames(hostnames)
+
+	return builder
+}
+
+// WithRule appends a rule to the grpcRoute. The rule must forward the matching calls to at least one backend.
+func (builder *GRPCRouteBuilder) WithRule(rule gatewayv1.GRPCRouteRule) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding rule to grpcRoute %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(rule.BackendRefs) == 0 {
+		builder.errorMsg = "grpcRoute rule must have at least one backendRef"
+
+		return builder
+	}
+
+	for _, backendRef := range rule.BackendRefs {
+		if err := validateBackendRef(backendRef.BackendRef); err != nil {
+			builder.errorMsg = fmt.Sprintf("grpcRoute rule backendRef is invalid: %s", err.Error())
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, rule)
+
+	return builder
+}
+
+// WithServiceRule appends a rule forwarding the calls to the given gRPC service, for example
+// grpc.health.v1.Health, to the port of the backend service.
+func (builder *GRPCRouteBuilder) WithServiceRule(grpcService, serviceName string, port int32) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if grpcService == "" {
+		builder.errorMsg = "grpcRoute rule 'grpcService' cannot be empty"
+
+		return builder
+	}
+
+	matchType := gatewayv1.GRPCMethodMatchExact
+
+	return builder.WithRule(gatewayv1.GRPCRouteRule{
+		Matches: []gatewayv1.GRPCRouteMatch{{
+			Method: &gatewayv1.GRPCMethodMatch{Type: &matchType, Service: &grpcService},
+		}},
+		BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: newServiceBackendRef(serviceName, port)}},
+	})
+}
+
+// WithOptions creates grpcRoute with generic mutation options.
+func (builder *GRPCRouteBuilder) WithOptions(options ...GRPCRouteAdditionalOptions) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting grpcRoute additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Get fetches the defined grpcRoute from the cluster.
+func (builder *GRPCRouteBuilder) Get() (*gatewayv1.GRPCRoute, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return getRoute[gatewayv1.GRPCRoute](builder.apiClient, grpcRouteKind, builder.Definition)
+}
+
+// Exists checks whether the given grpcRoute exists.
+func (builder *GRPCRouteBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if grpcRoute %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a grpcRoute in the cluster and stores the created object in struct.
+func (builder *GRPCRouteBuilder) Create() (*GRPCRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	err := createRoute(builder.apiClient, grpcRouteKind, builder.Definition, builder.Definition.Spec.ParentRefs)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing grpcRoute object with the grpcRoute definition in builder.
+func (builder *GRPCRouteBuilder) Update() (*GRPCRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("grpcRoute object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	err := updateRoute(builder.apiClient, grpcRouteKind, builder.Object, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Delete removes the grpcRoute from the cluster.
+func (builder *GRPCRouteBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := deleteRoute(builder.apiClient, grpcRouteKind, builder.Definition)
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsAccepted checks whether every parent gateway of the grpcRoute accepted its current generation.
+func (builder *GRPCRouteBuilder) IsAccepted() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if grpcRoute %s in namespace %s is accepted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("grpcRoute object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return isRouteAccepted(builder.Object.Status.RouteStatus, builder.Object.Generation), nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until every parent gateway accepted the
+// grpcRoute.
+func (builder *GRPCRouteBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until grpcRoute %s in namespace %s is accepted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for grpcRoute %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return waitUntilRouteAccepted(builder.IsAccepted, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *GRPCRouteBuilder) validate() (bool, error) {
+	if builder == nil {
+		glog.V(100).Infof("The GRPCRoute builder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil GRPCRoute builder")
+	}
+
+	return validateRoute("GRPCRoute", builder.Definition, builder.apiClient, builder.errorMsg)
+}
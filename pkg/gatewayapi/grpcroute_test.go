@@ -0,0 +1,631 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultGRPCService = "grpc.health.v1.Health"
+
+func TestNewGRPCRouteBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultRouteName,
+			namespace:     defaultGatewayNamespace,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultGatewayNamespace,
+			client:        true,
+			expectedError: "grpcRoute 'name' cannot be empty",
+		},
+		{
+			name:          defaultRouteName,
+			namespace:     "",
+			client:        true,
+			expectedError: "grpcRoute 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultRouteName,
+			namespace:     defaultGatewayNamespace,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+		}
+
+		testBuilder := NewGRPCRouteBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullGRPCRoute(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultRouteName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultRouteName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("grpcRoute object test-route does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("grpcRoute 'name' cannot be empty"),
+		},
+		{
+			name:                defaultRouteName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("grpcRoute 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultRouteName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("grpcRoute 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyGRPCRoute()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		testBuilder, err := PullGRPCRoute(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestGRPCRouteWithParentRef(t *testing.T) {
+	testCases := []struct {
+		gatewayName      string
+		gatewayNamespace string
+		sectionName      string
+		expectedError    string
+	}{
+		{
+			gatewayName:      defaultGatewayName,
+			gatewayNamespace: "",
+			sectionName:      "",
+			expectedError:    "",
+		},
+		{
+			gatewayName:      defaultGatewayName,
+			gatewayNamespace: defaultGatewayNamespace,
+			sectionName:      "grpc",
+			expectedError:    "",
+		},
+		{
+			gatewayName:      "",
+			gatewayNamespace: defaultGatewayNamespace,
+			sectionName:      "",
+			expectedError:    "grpcRoute parentRef 'gatewayName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewGRPCRouteBuilder(
+			clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}),
+			defaultRouteName, defaultGatewayNamespace).
+			WithParentRef(testCase.gatewayName, testCase.gatewayNamespace, testCase.sectionName)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t,
+				newParentReference(testCase.gatewayName, testCase.gatewayNamespace, testCase.sectionName),
+				testBuilder.Definition.Spec.ParentRefs[0])
+		}
+	}
+}
+
+func TestGRPCRouteWithHostnames(t *testing.T) {
+	testCases := []struct {
+		hostnames     []string
+		expectedError string
+	}{
+		{
+			hostnames:     []string{"grpc.example.com"},
+			expectedError: "",
+		},
+		{
+			hostnames:     []string{"grpc.example.com", "*.example.org"},
+			expectedError: "",
+		},
+		{
+			hostnames:     []string{},
+			expectedError: "grpcRoute 'hostnames' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()).
+			WithHostnames(testCase.hostnames...)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, newHostnames(testCase.hostnames), testBuilder.Definition.Spec.Hostnames)
+		}
+	}
+}
+
+func TestGRPCRouteWithRule(t *testing.T) {
+	testCases := []struct {
+		rule          gatewayv1.GRPCRouteRule
+		expectedError string
+	}{
+		{
+			rule: gatewayv1.GRPCRouteRule{
+				BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: newServiceBackendRef("backend", 9000)}},
+			},
+			expectedError: "",
+		},
+		{
+			rule:          gatewayv1.GRPCRouteRule{},
+			expectedError: "grpcRoute rule must have at least one backendRef",
+		},
+		{
+			rule: gatewayv1.GRPCRouteRule{
+				BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: newServiceBackendRef("", 9000)}},
+			},
+			expectedError: "grpcRoute rule backendRef is invalid: 'name' cannot be empty",
+		},
+		{
+			rule: gatewayv1.GRPCRouteRule{
+				BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: newServiceBackendRef("backend", 70000)}},
+			},
+			expectedError: "grpcRoute rule backendRef is invalid: port 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()).WithRule(testCase.rule)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []gatewayv1.GRPCRouteRule{testCase.rule}, testBuilder.Definition.Spec.Rules)
+		}
+	}
+}
+
+func TestGRPCRouteWithServiceRule(t *testing.T) {
+	testCases := []struct {
+		grpcService   string
+		serviceName   string
+		port          int32
+		expectedError string
+	}{
+		{
+			grpcService:   defaultGRPCService,
+			serviceName:   "backend",
+			port:          9000,
+			expectedError: "",
+		},
+		{
+			grpcService:   "",
+			serviceName:   "backend",
+			port:          9000,
+			expectedError: "grpcRoute rule 'grpcService' cannot be empty",
+		},
+		{
+			grpcService:   defaultGRPCService,
+			serviceName:   "",
+			port:          9000,
+			expectedError: "grpcRoute rule backendRef is invalid: 'name' cannot be empty",
+		},
+		{
+			grpcService:   defaultGRPCService,
+			serviceName:   "backend",
+			port:          0,
+			expectedError: "grpcRoute rule backendRef is invalid: port 0 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()).
+			WithServiceRule(testCase.grpcService, testCase.serviceName, testCase.port)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			rule := testBuilder.Definition.Spec.Rules[0]
+			assert.Equal(t, gatewayv1.GRPCMethodMatchExact, *rule.Matches[0].Method.Type)
+			assert.Equal(t, testCase.grpcService, *rule.Matches[0].Method.Service)
+			assert.Equal(t, gatewayv1.ObjectName(testCase.serviceName), rule.BackendRefs[0].Name)
+			assert.Equal(t, gatewayv1.PortNumber(testCase.port), *rule.BackendRefs[0].Port)
+		}
+	}
+}
+
+func TestGRPCRouteWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    GRPCRouteAdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *GRPCRouteBuilder) (*GRPCRouteBuilder, error) {
+				builder.Definition.Labels = map[string]string{"app": "test"}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *GRPCRouteBuilder) (*GRPCRouteBuilder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+		}
+	}
+}
+
+func TestGRPCRouteExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *GRPCRouteBuilder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestGRPCRouteCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GRPCRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: NewGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}),
+				defaultRouteName, defaultGatewayNamespace),
+			expectedError: fmt.Errorf("grpcRoute test-route must have at least one parentRef"),
+		},
+		{
+			testBuilder:   buildInvalidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: fmt.Errorf("grpcRoute 'hostnames' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestGRPCRouteUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GRPCRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf("grpcRoute object test-route does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: fmt.Errorf("grpcRoute 'hostnames' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithServiceRule(defaultGRPCService, "backend", 9000).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, testBuilder.Object.Spec.Rules, 1)
+		}
+	}
+}
+
+func TestGRPCRouteDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GRPCRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: fmt.Errorf("grpcRoute 'hostnames' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestGRPCRouteIsAccepted(t *testing.T) {
+	testCases := []struct {
+		testBuilder      *GRPCRouteBuilder
+		expectedAccepted bool
+		expectedError    error
+	}{
+		{
+			testBuilder:      buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedAccepted: true,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyGRPCRouteWithStatus(metav1.ConditionFalse)},
+				SchemeAttachers: testSchemes,
+			})),
+			expectedAccepted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyGRPCRouteWithStatus("")},
+				SchemeAttachers: testSchemes,
+			})),
+			expectedAccepted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedAccepted: false,
+			expectedError:    fmt.Errorf("grpcRoute object test-route does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		accepted, err := testCase.testBuilder.IsAccepted()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAccepted, accepted)
+	}
+}
+
+func TestGRPCRouteWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *GRPCRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidGRPCRouteBuilder(buildGRPCRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyGRPCRouteWithStatus(metav1.ConditionFalse)},
+				SchemeAttachers: testSchemes,
+			})),
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder: buildValidGRPCRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf(
+				"cannot wait for grpcRoute test-route which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitUntilAccepted(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestGRPCRouteValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil GRPCRoute builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined GRPCRoute",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "GRPCRoute builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGRPCRouteBuilder(
+			clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidGRPCRouteBuilder(apiClient *clients.Settings) *GRPCRouteBuilder {
+	return NewGRPCRouteBuilder(apiClient, defaultRouteName, defaultGatewayNamespace).
+		WithParentRef(defaultGatewayName, "", "")
+}
+
+func buildInvalidGRPCRouteBuilder(apiClient *clients.Settings) *GRPCRouteBuilder {
+	return buildValidGRPCRouteBuilder(apiClient).WithHostnames()
+}
+
+func buildGRPCRouteClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyGRPCRoute(),
+		SchemeAttachers: testSchemes,
+	})
+}
+
+func buildDummyGRPCRoute() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyGRPCRouteWithStatus(metav1.ConditionTrue))
+}
+
+func buildDummyGRPCRouteWithStatus(acceptedStatus metav1.ConditionStatus) *gatewayv1.GRPCRoute {
+	return &gatewayv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultRouteName,
+			Namespace: defaultGatewayNamespace,
+		},
+		Spec: gatewayv1.GRPCRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: gatewayv1.ObjectName(defaultGatewayName)}},
+			},
+		},
+		Status: gatewayv1.GRPCRouteStatus{RouteStatus: buildDummyRouteStatus(acceptedStatus)},
+	}
+}
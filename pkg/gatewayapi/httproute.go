@@ -0,0 +1,321 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const httpRouteKind = "httpRoute"
+
+// HTTPRouteBuilder provides a struct for httpRoute object from the cluster and an httpRoute definition.
+type HTTPRouteBuilder struct {
+	// httpRoute definition, used to create the httpRoute object.
+	Definition *gatewayv1.HTTPRoute
+	// Created httpRoute object.
+	Object *gatewayv1.HTTPRoute
+	// Used to store latest error message upon defining or mutating httpRoute definition.
+	errorMsg string
+	// api client to interact with the cluster.
+	apiClient goclient.Client
+}
+
+// HTTPRouteAdditionalOptions additional options for httpRoute object.
+type HTTPRouteAdditionalOptions func(builder *HTTPRouteBuilder) (*HTTPRouteBuilder, error)
+
+// NewHTTPRouteBuilder creates a new instance of HTTPRouteBuilder. The route is attached to gateways with
+// WithParentRef.
+func NewHTTPRouteBuilder(apiClient *clients.Settings, name, nsname string) *HTTPRouteBuilder {
+	glog.V(100).Infof(
+		"Initializing new httpRoute structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("httpRoute 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil
+	}
+
+	definition, errorMsg := newRouteDefinition[gatewayv1.HTTPRoute](httpRouteKind, name, nsname)
+
+	return &HTTPRouteBuilder{
+		apiClient:  apiClient.Client,
+		Definition: definition,
+		errorMsg:   errorMsg,
+	}
+}
+
+// PullHTTPRoute pulls existing httpRoute from cluster.
+func PullHTTPRoute(apiClient *clients.Settings, name, nsname string) (*HTTPRouteBuilder, error) {
+	object, err := pullRoute[gatewayv1.HTTPRoute](apiClient, httpRouteKind, name, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTTPRouteBuilder{
+		apiClient:  apiClient.Client,
+		Definition: object,
+		Object:     object,
+	}, nil
+}
+
+// WithParentRef attaches the httpRoute to the gateway. An empty gatewayNamespace refers to the namespace of the
+// route and an empty sectionName attaches the route to all the listeners of the gateway.
+func (builder *HTTPRouteBuilder) WithParentRef(gatewayName, gatewayNamespace, sectionName string) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding parentRef to gateway %s in namespace %s section %s to httpRoute %s in namespace %s",
+		gatewayName, gatewayNamespace, sectionName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if gatewayName == "" {
+		builder.errorMsg = "httpRoute parentRef 'gatewayName' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ParentRefs = append(builder.Definition.Spec.ParentRefs,
+		newParentReference(gatewayName, gatewayNamespace, sectionName))
+
+	return builder
+}
+
+// WithHostnames sets the hostnames matched against the Host header of the requests.
+func (builder *HTTPRouteBuilder) WithHostnames(hostnames ...string) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting hostnames %v in httpRoute %s in namespace %s",
+		hostnames, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(hostnames) == 0 {
+		builder.errorMsg = "httpRoute 'hostnames' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Hostnames = newHostnames(hostnames)
+
+	return builder
+}
+
+// WithRule appends a rule to the httpRoute. The rule must forward the matching requests to at least one backend.
+func (builder *HTTPRouteBuilder) WithRule(rule gatewayv1.HTTPRouteRule) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding rule to httpRoute %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(rule.BackendRefs) == 0 {
+		builder.errorMsg = "httpRoute rule must have at least one backendRef"
+
+		return builder
+	}
+
+	for _, backendRef := range rule.BackendRefs {
+		if err := validateBackendRef(backendRef.BackendRef); err != nil {
+			builder.errorMsg = fmt.Sprintf("httpRoute rule backendRef is invalid: %s", err.Error())
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, rule)
+
+	return builder
+}
+
+// WithPathPrefixRule appends a rule forwarding the requests whose path starts with pathPrefix to the port of the
+// service.
+func (builder *HTTPRouteBuilder) WithPathPrefixRule(pathPrefix, serviceName string, port int32) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if pathPrefix == "" || pathPrefix[0] != '/' {
+		builder.errorMsg = fmt.Sprintf("httpRoute rule path %s must be an absolute path", pathPrefix)
+
+		return builder
+	}
+
+	pathType := gatewayv1.PathMatchPathPrefix
+
+	return builder.WithRule(gatewayv1.HTTPRouteRule{
+		Matches: []gatewayv1.HTTPRouteMatch{{
+			Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &pathPrefix},
+		}},
+		BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: newServiceBackendRef(serviceName, port)}},
+	})
+}
+
+// WithOptions creates httpRoute with generic mutation options.
+func (builder *HTTPRouteBuilder) WithOptions(options ...HTTPRouteAdditionalOptions) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting httpRoute additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Get fetches the defined httpRoute from the cluster.
+func (builder *HTTPRouteBuilder) Get() (*gatewayv1.HTTPRoute, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return getRoute[gatewayv1.HTTPRoute](builder.apiClient, httpRouteKind, builder.Definition)
+}
+
+// Exists checks whether the given httpRoute exists.
+func (builder *HTTPRouteBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if httpRoute %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an httpRoute in the cluster and stores the created object in struct.
+func (builder *HTTPRouteBuilder) Create() (*HTTPRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	err := createRoute(builder.apiClient, httpRouteKind, builder.Definition, builder.Definition.Spec.ParentRefs)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing httpRoute object with the httpRoute definition in builder.
+func (builder *HTTPRouteBuilder) Update() (*HTTPRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("httpRoute object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	err := updateRoute(builder.apiClient, httpRouteKind, builder.Object, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Delete removes the httpRoute from the cluster.
+func (builder *HTTPRouteBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := deleteRoute(builder.apiClient, httpRouteKind, builder.Definition)
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsAccepted checks whether every parent gateway of the httpRoute accepted its current generation.
+func (builder *HTTPRouteBuilder) IsAccepted() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if httpRoute %s in namespace %s is accepted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("httpRoute object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return isRouteAccepted(builder.Object.Status.RouteStatus, builder.Object.Generation), nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until every parent gateway accepted the
+// httpRoute.
+func (builder *HTTPRouteBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until httpRoute %s in namespace %s is accepted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for httpRoute %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return waitUntilRouteAccepted(builder.IsAccepted, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HTTPRouteBuilder) validate() (bool, error) {
+	if builder == nil {
+		glog.V(100).Infof("The HTTPRoute builder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil HTTPRoute builder")
+	}
+
+	return validateRoute("HTTPRoute", builder.Definition, builder.apiClient, builder.errorMsg)
+}
@@ -0,0 +1,650 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultRouteName = "test-route"
+
+func TestNewHTTPRouteBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultRouteName,
+			namespace:     defaultGatewayNamespace,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultGatewayNamespace,
+			client:        true,
+			expectedError: "httpRoute 'name' cannot be empty",
+		},
+		{
+			name:          defaultRouteName,
+			namespace:     "",
+			client:        true,
+			expectedError: "httpRoute 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultRouteName,
+			namespace:     defaultGatewayNamespace,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+		}
+
+		testBuilder := NewHTTPRouteBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullHTTPRoute(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultRouteName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultRouteName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("httpRoute object test-route does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("httpRoute 'name' cannot be empty"),
+		},
+		{
+			name:                defaultRouteName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("httpRoute 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultRouteName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("httpRoute 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyHTTPRoute()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		testBuilder, err := PullHTTPRoute(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestHTTPRouteWithParentRef(t *testing.T) {
+	testCases := []struct {
+		gatewayName      string
+		gatewayNamespace string
+		sectionName      string
+		expectedError    string
+	}{
+		{
+			gatewayName:      defaultGatewayName,
+			gatewayNamespace: "",
+			sectionName:      "",
+			expectedError:    "",
+		},
+		{
+			gatewayName:      defaultGatewayName,
+			gatewayNamespace: defaultGatewayNamespace,
+			sectionName:      "http",
+			expectedError:    "",
+		},
+		{
+			gatewayName:      "",
+			gatewayNamespace: defaultGatewayNamespace,
+			sectionName:      "",
+			expectedError:    "httpRoute parentRef 'gatewayName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewHTTPRouteBuilder(
+			clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}),
+			defaultRouteName, defaultGatewayNamespace).
+			WithParentRef(testCase.gatewayName, testCase.gatewayNamespace, testCase.sectionName)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t,
+				newParentReference(testCase.gatewayName, testCase.gatewayNamespace, testCase.sectionName),
+				testBuilder.Definition.Spec.ParentRefs[0])
+		}
+	}
+}
+
+func TestHTTPRouteWithHostnames(t *testing.T) {
+	testCases := []struct {
+		hostnames     []string
+		expectedError string
+	}{
+		{
+			hostnames:     []string{"app.example.com"},
+			expectedError: "",
+		},
+		{
+			hostnames:     []string{"app.example.com", "*.example.org"},
+			expectedError: "",
+		},
+		{
+			hostnames:     []string{},
+			expectedError: "httpRoute 'hostnames' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()).
+			WithHostnames(testCase.hostnames...)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, newHostnames(testCase.hostnames), testBuilder.Definition.Spec.Hostnames)
+		}
+	}
+}
+
+func TestHTTPRouteWithRule(t *testing.T) {
+	testCases := []struct {
+		rule          gatewayv1.HTTPRouteRule
+		expectedError string
+	}{
+		{
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: newServiceBackendRef("backend", 8080)}},
+			},
+			expectedError: "",
+		},
+		{
+			rule:          gatewayv1.HTTPRouteRule{},
+			expectedError: "httpRoute rule must have at least one backendRef",
+		},
+		{
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: newServiceBackendRef("", 8080)}},
+			},
+			expectedError: "httpRoute rule backendRef is invalid: 'name' cannot be empty",
+		},
+		{
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: newServiceBackendRef("backend", 70000)}},
+			},
+			expectedError: "httpRoute rule backendRef is invalid: port 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()).WithRule(testCase.rule)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []gatewayv1.HTTPRouteRule{testCase.rule}, testBuilder.Definition.Spec.Rules)
+		}
+	}
+}
+
+func TestHTTPRouteWithPathPrefixRule(t *testing.T) {
+	testCases := []struct {
+		pathPrefix    string
+		serviceName   string
+		port          int32
+		expectedError string
+	}{
+		{
+			pathPrefix:    "/api",
+			serviceName:   "backend",
+			port:          8080,
+			expectedError: "",
+		},
+		{
+			pathPrefix:    "api",
+			serviceName:   "backend",
+			port:          8080,
+			expectedError: "httpRoute rule path api must be an absolute path",
+		},
+		{
+			pathPrefix:    "",
+			serviceName:   "backend",
+			port:          8080,
+			expectedError: "httpRoute rule path  must be an absolute path",
+		},
+		{
+			pathPrefix:    "/",
+			serviceName:   "backend",
+			port:          70000,
+			expectedError: "httpRoute rule backendRef is invalid: port 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()).
+			WithPathPrefixRule(testCase.pathPrefix, testCase.serviceName, testCase.port)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			rule := testBuilder.Definition.Spec.Rules[0]
+			assert.Equal(t, gatewayv1.PathMatchPathPrefix, *rule.Matches[0].Path.Type)
+			assert.Equal(t, testCase.pathPrefix, *rule.Matches[0].Path.Value)
+			assert.Equal(t, gatewayv1.ObjectName(testCase.serviceName), rule.BackendRefs[0].Name)
+			assert.Equal(t, gatewayv1.PortNumber(testCase.port), *rule.BackendRefs[0].Port)
+		}
+	}
+}
+
+func TestHTTPRouteWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    HTTPRouteAdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *HTTPRouteBuilder) (*HTTPRouteBuilder, error) {
+				builder.Definition.Labels = map[string]string{"app": "test"}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *HTTPRouteBuilder) (*HTTPRouteBuilder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+		}
+	}
+}
+
+func TestHTTPRouteExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *HTTPRouteBuilder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestHTTPRouteCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *HTTPRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: NewHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}),
+				defaultRouteName, defaultGatewayNamespace),
+			expectedError: fmt.Errorf("httpRoute test-route must have at least one parentRef"),
+		},
+		{
+			testBuilder:   buildInvalidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: fmt.Errorf("httpRoute 'hostnames' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestHTTPRouteUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *HTTPRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf("httpRoute object test-route does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: fmt.Errorf("httpRoute 'hostnames' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithPathPrefixRule("/", "backend", 8080).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, testBuilder.Object.Spec.Rules, 1)
+		}
+	}
+}
+
+func TestHTTPRouteDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *HTTPRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: fmt.Errorf("httpRoute 'hostnames' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestHTTPRouteIsAccepted(t *testing.T) {
+	testCases := []struct {
+		testBuilder      *HTTPRouteBuilder
+		expectedAccepted bool
+		expectedError    error
+	}{
+		{
+			testBuilder:      buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedAccepted: true,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyHTTPRouteWithStatus(metav1.ConditionFalse)},
+				SchemeAttachers: testSchemes,
+			})),
+			expectedAccepted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyHTTPRouteWithStatus("")},
+				SchemeAttachers: testSchemes,
+			})),
+			expectedAccepted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedAccepted: false,
+			expectedError:    fmt.Errorf("httpRoute object test-route does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		accepted, err := testCase.testBuilder.IsAccepted()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAccepted, accepted)
+	}
+}
+
+func TestHTTPRouteWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *HTTPRouteBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHTTPRouteBuilder(buildHTTPRouteClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyHTTPRouteWithStatus(metav1.ConditionFalse)},
+				SchemeAttachers: testSchemes,
+			})),
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder: buildValidHTTPRouteBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf(
+				"cannot wait for httpRoute test-route which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitUntilAccepted(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestHTTPRouteValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil HTTPRoute builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined HTTPRoute",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "HTTPRoute builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(
+			clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidHTTPRouteBuilder(apiClient *clients.Settings) *HTTPRouteBuilder {
+	return NewHTTPRouteBuilder(apiClient, defaultRouteName, defaultGatewayNamespace).
+		WithParentRef(defaultGatewayName, "", "")
+}
+
+func buildInvalidHTTPRouteBuilder(apiClient *clients.Settings) *HTTPRouteBuilder {
+	return buildValidHTTPRouteBuilder(apiClient).WithHostnames()
+}
+
+func buildHTTPRouteClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyHTTPRoute(),
+		SchemeAttachers: testSchemes,
+	})
+}
+
+func buildDummyHTTPRoute() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyHTTPRouteWithStatus(metav1.ConditionTrue))
+}
+
+func buildDummyHTTPRouteWithStatus(acceptedStatus metav1.ConditionStatus) *gatewayv1.HTTPRoute {
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultRouteName,
+			Namespace: defaultGatewayNamespace,
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: gatewayv1.ObjectName(defaultGatewayName)}},
+			},
+		},
+		Status: gatewayv1.HTTPRouteStatus{RouteStatus: buildDummyRouteStatus(acceptedStatus)},
+	}
+}
+
+// buildDummyRouteStatus returns a route status with a single parent gateway. An empty acceptedStatus returns a status
+// without parents, as reported before any gateway picked the route up.
+func buildDummyRouteStatus(acceptedStatus metav1.ConditionStatus) gatewayv1.RouteStatus {
+	if acceptedStatus == "" {
+		return gatewayv1.RouteStatus{}
+	}
+
+	return gatewayv1.RouteStatus{
+		Parents: []gatewayv1.RouteParentStatus{{
+			ParentRef:      gatewayv1.ParentReference{Name: gatewayv1.ObjectName(defaultGatewayName)},
+			ControllerName: gatewayv1.GatewayController(defaultControllerName),
+			Conditions: []metav1.Condition{{
+				Type:   string(gatewayv1.RouteConditionAccepted),
+				Status: acceptedStatus,
+			}},
+		}},
+	}
+}
@@ -0,0 +1,173 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	gatewayv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// routeObject is the pointer type of a route, such as *gatewayv1.HTTPRoute. It lets the route builders share the
+// lifecycle of the route, which does not depend on the protocol specific rules.
+type routeObject[T any] interface {
+	*T
+	goclient.Object
+}
+
+// newRouteDefinition returns a route with the given name and namespace along with the error message of the builder,
+// which is empty unless the name or the namespace is empty.
+func newRouteDefinition[T any, R routeObject[T]](kind, name, nsname string) (R, string) {
+	route := R(new(T))
+	route.SetName(name)
+	route.SetNamespace(nsname)
+
+	if name == "" {
+		glog.V(100).Infof("The name of the %s is empty", kind)
+
+		return route, fmt.Sprintf("%s 'name' cannot be empty", kind)
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the %s is empty", kind)
+
+		return route, fmt.Sprintf("%s 'nsname' cannot be empty", kind)
+	}
+
+	return route, ""
+}
+
+// pullRoute returns the route with the given name and namespace from the cluster.
+func pullRoute[T any, R routeObject[T]](apiClient *clients.Settings, kind, name, nsname string) (R, error) {
+	glog.V(100).Infof("Pulling existing %s name %s in namespace %s from cluster", kind, name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("%s 'apiClient' cannot be empty", kind)
+	}
+
+	err := apiClient.AttachScheme(gatewayv1.AddToScheme)
+	if err != nil {
+		glog.V(100).Infof("Failed to add gateway api v1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	definition, errorMsg := newRouteDefinition[T, R](kind, name, nsname)
+	if errorMsg != "" {
+		return nil, fmt.Errorf(errorMsg)
+	}
+
+	route, err := getRoute[T, R](apiClient.Client, kind, definition)
+	if err != nil {
+		return nil, fmt.Errorf("%s object %s does not exist in namespace %s", kind, name, nsname)
+	}
+
+	return route, nil
+}
+
+// getRoute fetches the route with the name and namespace of the definition from the cluster.
+func getRoute[T any, R routeObject[T]](apiClient goclient.Client, kind string, definition R) (R, error) {
+	glog.V(100).Infof("Getting %s %s in namespace %s", kind, definition.GetName(), definition.GetNamespace())
+
+	route := R(new(T))
+
+	err := apiClient.Get(context.TODO(), goclient.ObjectKeyFromObject(definition), route)
+	if err != nil {
+		return nil, err
+	}
+
+	return route, nil
+}
+
+// createRoute creates the route in the cluster once it is attached to at least one gateway.
+func createRoute(
+	apiClient goclient.Client, kind string, definition goclient.Object, parentRefs []gatewayv1.ParentReference) error {
+	glog.V(100).Infof("Creating the %s %s in namespace %s", kind, definition.GetName(), definition.GetNamespace())
+
+	if len(parentRefs) == 0 {
+		return fmt.Errorf("%s %s must have at least one parentRef", kind, definition.GetName())
+	}
+
+	return apiClient.Create(context.TODO(), definition)
+}
+
+// updateRoute renovates the existing route object with the definition.
+func updateRoute(apiClient goclient.Client, kind string, object, definition goclient.Object) error {
+	glog.V(100).Infof("Updating the %s %s in namespace %s", kind, definition.GetName(), definition.GetNamespace())
+
+	definition.SetResourceVersion(object.GetResourceVersion())
+
+	err := apiClient.Update(context.TODO(), definition)
+	if err != nil {
+		return fmt.Errorf("cannot update %s: %w", kind, err)
+	}
+
+	return nil
+}
+
+// deleteRoute removes the route from the cluster.
+func deleteRoute(apiClient goclient.Client, kind string, definition goclient.Object) error {
+	glog.V(100).Infof("Deleting the %s %s from namespace %s", kind, definition.GetName(), definition.GetNamespace())
+
+	err := apiClient.Delete(context.TODO(), definition)
+	if err != nil {
+		return fmt.Errorf("cannot delete %s: %w", kind, err)
+	}
+
+	return nil
+}
+
+// waitUntilRouteAccepted polls isAccepted for the duration of the timeout or until it reports the route accepted.
+func waitUntilRouteAccepted(isAccepted func() (bool, error), timeout time.Duration) error {
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			accepted, err := isAccepted()
+			if err != nil {
+				return false, nil
+			}
+
+			return accepted, nil
+		})
+}
+
+// validateRoute checks that the definition, apiClient and errorMsg of a non nil route builder are valid.
+func validateRoute[T any, R routeObject[T]](
+	resourceCRD string, definition R, apiClient goclient.Client, errorMsg string) (bool, error) {
+	if definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, errorMsg)
+
+		return false, fmt.Errorf(errorMsg)
+	}
+
+	return true, nil
+}
+
+// newHostnames converts the hostnames matched by a route.
+func newHostnames(hostnames []string) []gatewayv1.Hostname {
+	var routeHostnames []gatewayv1.Hostname
+
+	for _, hostname := range hostnames {
+		routeHostnames = append(routeHostnames, gatewayv1.Hostname(hostname))
+	}
+
+	return routeHostnames
+}
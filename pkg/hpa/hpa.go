@@ -0,0 +1,593 @@
+package hpa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/keda"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// kedaHPANamePrefix is the prefix KEDA uses when naming the HPA generated for a ScaledObject.
+const kedaHPANamePrefix = "keda-hpa-"
+
+// Builder provides struct for horizontalpodautoscaler object containing connection to the cluster and the
+// horizontalpodautoscaler definitions.
+type Builder struct {
+	// HorizontalPodAutoscaler definition. Used to create a horizontalpodautoscaler object.
+	Definition *autoscalingv2.HorizontalPodAutoscaler
+	// Created horizontalpodautoscaler object.
+	Object *autoscalingv2.HorizontalPodAutoscaler
+	// Used in functions that define or mutate horizontalpodautoscaler definition. errorMsg is processed before the
+	// horizontalpodautoscaler object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for horizontalpodautoscaler object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+var retryInterval = time.Second * 3
+
+// NewBuilder creates a new instance of Builder scaling the given target between one and maxReplicas replicas.
+func NewBuilder(
+	apiClient *clients.Settings,
+	name, nsname string,
+	scaleTargetRef autoscalingv2.CrossVersionObjectReference,
+	maxReplicas int32) *Builder {
+	glog.V(100).Infof(
+		"Initializing new horizontalpodautoscaler structure with the following params: "+
+			"name: %s, namespace: %s, scaleTargetRef: %v, maxReplicas: %d",
+		name, nsname, scaleTargetRef, maxReplicas)
+
+	if apiClient == nil {
+		glog.V(100).Infof("horizontalpodautoscaler 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: scaleTargetRef,
+				MaxReplicas:    maxReplicas,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the horizontalpodautoscaler is empty")
+
+		builder.errorMsg = "horizontalpodautoscaler 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the horizontalpodautoscaler is empty")
+
+		builder.errorMsg = "horizontalpodautoscaler 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if scaleTargetRef.Kind == "" || scaleTargetRef.Name == "" {
+		glog.V(100).Infof("The scaleTargetRef of the horizontalpodautoscaler is incomplete")
+
+		builder.errorMsg = "horizontalpodautoscaler 'scaleTargetRef' must have a kind and a name"
+
+		return builder
+	}
+
+	if maxReplicas < 1 {
+		glog.V(100).Infof("The maxReplicas of the horizontalpodautoscaler is less than 1")
+
+		builder.errorMsg = "horizontalpodautoscaler 'maxReplicas' must be at least 1"
+
+		return builder
+	}
+
+	return builder
+}
+
+// Pull loads an existing horizontalpodautoscaler into the Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing horizontalpodautoscaler name: %s under namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("horizontalpodautoscaler 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("horizontalpodautoscaler 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("horizontalpodautoscaler 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("horizontalpodautoscaler object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// PullFromScaledObject loads the horizontalpodautoscaler that KEDA generated for the given ScaledObject. The name
+// is taken from the ScaledObject status, then from its advanced HPA configuration, and defaults to the keda-hpa-
+// prefix followed by the ScaledObject name.
+func PullFromScaledObject(apiClient *clients.Settings, scaledObject *keda.ScaledObjectBuilder) (*Builder, error) {
+	if scaledObject == nil || scaledObject.Definition == nil {
+		glog.V(100).Infof("The scaledObject is empty")
+
+		return nil, fmt.Errorf("horizontalpodautoscaler 'scaledObject' cannot be empty")
+	}
+
+	glog.V(100).Infof("Pulling horizontalpodautoscaler generated for scaledObject %s in namespace %s",
+		scaledObject.Definition.Name, scaledObject.Definition.Namespace)
+
+	if !scaledObject.Exists() || scaledObject.Object == nil {
+		return nil, fmt.Errorf("scaledObject object %s does not exist in namespace %s",
+			scaledObject.Definition.Name, scaledObject.Definition.Namespace)
+	}
+
+	hpaName := kedaHPANamePrefix + scaledObject.Object.Name
+
+	if advanced := scaledObject.Object.Spec.Advanced; advanced != nil &&
+		advanced.HorizontalPodAutoscalerConfig != nil && advanced.HorizontalPodAutoscalerConfig.Name != "" {
+		hpaName = advanced.HorizontalPodAutoscalerConfig.Name
+	}
+
+	if scaledObject.Object.Status.HpaName != "" {
+		hpaName = scaledObject.Object.Status.HpaName
+	}
+
+	return Pull(apiClient, hpaName, scaledObject.Object.Namespace)
+}
+
+// WithMinReplicas sets the lower limit for the number of replicas the horizontalpodautoscaler can scale down to.
+func (builder *Builder) WithMinReplicas(minReplicas int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting minReplicas %d in horizontalpodautoscaler %s in namespace %s",
+		minReplicas, builder.Definition.Name, builder.Definition.Namespace)
+
+	if minReplicas < 0 || minReplicas > builder.Definition.Spec.MaxReplicas {
+		builder.errorMsg = fmt.Sprintf("horizontalpodautoscaler 'minReplicas' must be between 0 and maxReplicas %d",
+			builder.Definition.Spec.MaxReplicas)
+
+		return builder
+	}
+
+	builder.Definition.Spec.MinReplicas = &minReplicas
+
+	return builder
+}
+
+// WithResourceMetric adds a resource metric targeting the given average utilization in percent of the requests of
+// the resource, for example cpu or memory.
+func (builder *Builder) WithResourceMetric(resourceName corev1.ResourceName, averageUtilization int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding resource metric %s with averageUtilization %d to horizontalpodautoscaler %s "+
+		"in namespace %s", resourceName, averageUtilization, builder.Definition.Name, builder.Definition.Namespace)
+
+	if resourceName == "" {
+		builder.errorMsg = "horizontalpodautoscaler resource metric 'resourceName' cannot be empty"
+
+		return builder
+	}
+
+	if averageUtilization < 1 {
+		builder.errorMsg = "horizontalpodautoscaler resource metric 'averageUtilization' must be at least 1"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Metrics = append(builder.Definition.Spec.Metrics, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resourceName,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &averageUtilization,
+			},
+		},
+	})
+
+	return builder
+}
+
+// WithPodsMetric adds a custom metric describing each pod of the scale target, targeting the given average value
+// across the pods.
+func (builder *Builder) WithPodsMetric(metricName string, averageValue resource.Quantity) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding pods metric %s with averageValue %s to horizontalpodautoscaler %s in namespace %s",
+		metricName, averageValue.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if metricName == "" {
+		builder.errorMsg = "horizontalpodautoscaler pods metric 'metricName' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Metrics = append(builder.Definition.Spec.Metrics, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: metricName},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: &averageValue,
+			},
+		},
+	})
+
+	return builder
+}
+
+// WithExternalMetric adds a metric not associated with any Kubernetes object, such as the length of a queue. The
+// target must be of the Value or AverageValue type.
+func (builder *Builder) WithExternalMetric(
+	metricName string, selector *metav1.LabelSelector, target autoscalingv2.MetricTarget) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding external metric %s with target %v to horizontalpodautoscaler %s in namespace %s",
+		metricName, target, builder.Definition.Name, builder.Definition.Namespace)
+
+	if metricName == "" {
+		builder.errorMsg = "horizontalpodautoscaler external metric 'metricName' cannot be empty"
+
+		return builder
+	}
+
+	switch {
+	case target.Type == autoscalingv2.ValueMetricType && target.Value != nil:
+	case target.Type == autoscalingv2.AverageValueMetricType && target.AverageValue != nil:
+	default:
+		builder.errorMsg = "horizontalpodautoscaler external metric 'target' must set a Value or an AverageValue"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Metrics = append(builder.Definition.Spec.Metrics, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ExternalMetricSourceType,
+		External: &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: metricName, Selector: selector},
+			Target: target,
+		},
+	})
+
+	return builder
+}
+
+// WithScaleUpBehavior sets the scaling policies and stabilization window used when scaling up.
+func (builder *Builder) WithScaleUpBehavior(rules autoscalingv2.HPAScalingRules) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting scaleUp behavior %v in horizontalpodautoscaler %s in namespace %s",
+		rules, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateScalingRules(rules); err != nil {
+		builder.errorMsg = fmt.Sprintf("horizontalpodautoscaler 'scaleUp' behavior is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.behavior().ScaleUp = &rules
+
+	return builder
+}
+
+// WithScaleDownBehavior sets the scaling policies and stabilization window used when scaling down.
+func (builder *Builder) WithScaleDownBehavior(rules autoscalingv2.HPAScalingRules) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting scaleDown behavior %v in horizontalpodautoscaler %s in namespace %s",
+		rules, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateScalingRules(rules); err != nil {
+		builder.errorMsg = fmt.Sprintf("horizontalpodautoscaler 'scaleDown' behavior is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.behavior().ScaleDown = &rules
+
+	return builder
+}
+
+// WithOptions creates horizontalpodautoscaler with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting horizontalpodautoscaler additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Exists checks whether the given horizontalpodautoscaler exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if horizontalpodautoscaler %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+		builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create builds horizontalpodautoscaler in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+			builder.Definition.Namespace).Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing horizontalpodautoscaler object with the horizontalpodautoscaler definition in
+// builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("horizontalpodautoscaler object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+		builder.Definition.Namespace).Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the horizontalpodautoscaler.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete horizontalpodautoscaler: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilDesiredReplicas waits for the duration of the defined timeout or until the horizontalpodautoscaler
+// reports the given number of desired replicas and the scale target runs that many replicas.
+func (builder *Builder) WaitUntilDesiredReplicas(replicas int32, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until horizontalpodautoscaler %s in namespace %s has %d "+
+		"desired replicas", builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for horizontalpodautoscaler %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			return builder.Object.Status.DesiredReplicas == replicas &&
+				builder.Object.Status.CurrentReplicas == replicas, nil
+		})
+}
+
+// WaitForScaleEvent waits for the duration of the defined timeout or until the horizontalpodautoscaler scales its
+// target, that is until its last scale time moves past the one observed when the wait started. The new replica
+// counts are available in the Object status once the wait returns.
+func (builder *Builder) WaitForScaleEvent(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until horizontalpodautoscaler %s in namespace %s scales",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for horizontalpodautoscaler %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var lastScaleTime time.Time
+
+	if builder.Object.Status.LastScaleTime != nil {
+		lastScaleTime = builder.Object.Status.LastScaleTime.Time
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, false, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			scaleTime := builder.Object.Status.LastScaleTime
+
+			return scaleTime != nil && scaleTime.Time.After(lastScaleTime), nil
+		})
+}
+
+// IsScalingActive reports whether the horizontalpodautoscaler is able to compute a scale, based on its
+// ScalingActive condition.
+func (builder *Builder) IsScalingActive() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if scaling is active for horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("horizontalpodautoscaler object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for _, condition := range builder.Object.Status.Conditions {
+		if condition.Type == autoscalingv2.ScalingActive {
+			return condition.Status == corev1.ConditionTrue, nil
+		}
+	}
+
+	return false, nil
+}
+
+// behavior returns the behavior of the definition, initializing it if needed.
+func (builder *Builder) behavior() *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	if builder.Definition.Spec.Behavior == nil {
+		builder.Definition.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{}
+	}
+
+	return builder.Definition.Spec.Behavior
+}
+
+func validateScalingRules(rules autoscalingv2.HPAScalingRules) error {
+	if rules.StabilizationWindowSeconds != nil &&
+		(*rules.StabilizationWindowSeconds < 0 || *rules.StabilizationWindowSeconds > 3600) {
+		return fmt.Errorf("stabilizationWindowSeconds must be between 0 and 3600")
+	}
+
+	for _, policy := range rules.Policies {
+		if policy.Type != autoscalingv2.PodsScalingPolicy && policy.Type != autoscalingv2.PercentScalingPolicy {
+			return fmt.Errorf("policy type %s is not supported", policy.Type)
+		}
+
+		if policy.Value < 1 {
+			return fmt.Errorf("policy value must be at least 1")
+		}
+
+		if policy.PeriodSeconds < 1 || policy.PeriodSeconds > 1800 {
+			return fmt.Errorf("policy periodSeconds must be between 1 and 1800")
+		}
+	}
+
+	return nil
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "HorizontalPodAutoscaler"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,862 @@
+package hpa
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kedav2v1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/keda"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var (
+	defaultHPAName         = "test-hpa"
+	defaultHPANamespace    = "test-namespace"
+	defaultHPAMaxReplicas  = int32(3)
+	defaultHPAScaledObject = "test-scaledobject"
+	defaultHPATarget       = autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "test-deployment",
+	}
+)
+
+func TestHPANewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		target        autoscalingv2.CrossVersionObjectReference
+		maxReplicas   int32
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultHPAName,
+			namespace:     defaultHPANamespace,
+			target:        defaultHPATarget,
+			maxReplicas:   defaultHPAMaxReplicas,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultHPANamespace,
+			target:        defaultHPATarget,
+			maxReplicas:   defaultHPAMaxReplicas,
+			client:        true,
+			expectedError: "horizontalpodautoscaler 'name' cannot be empty",
+		},
+		{
+			name:          defaultHPAName,
+			namespace:     "",
+			target:        defaultHPATarget,
+			maxReplicas:   defaultHPAMaxReplicas,
+			client:        true,
+			expectedError: "horizontalpodautoscaler 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultHPAName,
+			namespace:     defaultHPANamespace,
+			target:        autoscalingv2.CrossVersionObjectReference{Kind: "Deployment"},
+			maxReplicas:   defaultHPAMaxReplicas,
+			client:        true,
+			expectedError: "horizontalpodautoscaler 'scaleTargetRef' must have a kind and a name",
+		},
+		{
+			name:          defaultHPAName,
+			namespace:     defaultHPANamespace,
+			target:        defaultHPATarget,
+			maxReplicas:   0,
+			client:        true,
+			expectedError: "horizontalpodautoscaler 'maxReplicas' must be at least 1",
+		},
+		{
+			name:          defaultHPAName,
+			namespace:     defaultHPANamespace,
+			target:        defaultHPATarget,
+			maxReplicas:   defaultHPAMaxReplicas,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilder(
+			testSettings, testCase.name, testCase.namespace, testCase.target, testCase.maxReplicas)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, testCase.target, testBuilder.Definition.Spec.ScaleTargetRef)
+			assert.Equal(t, testCase.maxReplicas, testBuilder.Definition.Spec.MaxReplicas)
+		}
+	}
+}
+
+func TestHPAPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultHPAName,
+			namespace:           defaultHPANamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultHPAName,
+			namespace:           defaultHPANamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"horizontalpodautoscaler object test-hpa does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultHPANamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("horizontalpodautoscaler 'name' cannot be empty"),
+		},
+		{
+			name:                defaultHPAName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("horizontalpodautoscaler 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultHPAName,
+			namespace:           defaultHPANamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("horizontalpodautoscaler 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyHPA()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestHPAPullFromScaledObject(t *testing.T) {
+	testCases := []struct {
+		advancedName        string
+		statusName          string
+		nilBuilder          bool
+		addToRuntimeObjects bool
+		expectedName        string
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedName:        "keda-hpa-test-scaledobject",
+			expectedError:       nil,
+		},
+		{
+			advancedName:        "advanced-hpa",
+			addToRuntimeObjects: true,
+			expectedName:        "advanced-hpa",
+			expectedError:       nil,
+		},
+		{
+			advancedName:        "advanced-hpa",
+			statusName:          "status-hpa",
+			addToRuntimeObjects: true,
+			expectedName:        "status-hpa",
+			expectedError:       nil,
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf(
+				"scaledObject object test-scaledobject does not exist in namespace test-namespace"),
+		},
+		{
+			nilBuilder:    true,
+			expectedError: fmt.Errorf("horizontalpodautoscaler 'scaledObject' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			scaledObject := &kedav2v1alpha1.ScaledObject{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      defaultHPAScaledObject,
+					Namespace: defaultHPANamespace,
+				},
+				Status: kedav2v1alpha1.ScaledObjectStatus{
+					HpaName: testCase.statusName,
+				},
+			}
+
+			if testCase.advancedName != "" {
+				scaledObject.Spec.Advanced = &kedav2v1alpha1.AdvancedConfig{
+					HorizontalPodAutoscalerConfig: &kedav2v1alpha1.HorizontalPodAutoscalerConfig{
+						Name: testCase.advancedName,
+					},
+				}
+			}
+
+			runtimeObjects = append(runtimeObjects, scaledObject, buildDummyHPAWithName(testCase.expectedName))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		})
+
+		var scaledObjectBuilder *keda.ScaledObjectBuilder
+
+		if !testCase.nilBuilder {
+			scaledObjectBuilder = keda.NewScaledObjectBuilder(testSettings, defaultHPAScaledObject, defaultHPANamespace)
+		}
+
+		testBuilder, err := PullFromScaledObject(testSettings, scaledObjectBuilder)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestHPAWithMinReplicas(t *testing.T) {
+	testCases := []struct {
+		minReplicas   int32
+		expectedError string
+	}{
+		{
+			minReplicas:   0,
+			expectedError: "",
+		},
+		{
+			minReplicas:   defaultHPAMaxReplicas,
+			expectedError: "",
+		},
+		{
+			minReplicas:   -1,
+			expectedError: "horizontalpodautoscaler 'minReplicas' must be between 0 and maxReplicas 3",
+		},
+		{
+			minReplicas:   defaultHPAMaxReplicas + 1,
+			expectedError: "horizontalpodautoscaler 'minReplicas' must be between 0 and maxReplicas 3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).WithMinReplicas(testCase.minReplicas)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.minReplicas, *testBuilder.Definition.Spec.MinReplicas)
+		}
+	}
+}
+
+func TestHPAWithResourceMetric(t *testing.T) {
+	testCases := []struct {
+		resourceName       corev1.ResourceName
+		averageUtilization int32
+		expectedError      string
+	}{
+		{
+			resourceName:       corev1.ResourceCPU,
+			averageUtilization: 80,
+			expectedError:      "",
+		},
+		{
+			resourceName:       "",
+			averageUtilization: 80,
+			expectedError:      "horizontalpodautoscaler resource metric 'resourceName' cannot be empty",
+		},
+		{
+			resourceName:       corev1.ResourceMemory,
+			averageUtilization: 0,
+			expectedError:      "horizontalpodautoscaler resource metric 'averageUtilization' must be at least 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).
+			WithResourceMetric(testCase.resourceName, testCase.averageUtilization)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Len(t, testBuilder.Definition.Spec.Metrics, 1)
+			assert.Equal(t, autoscalingv2.ResourceMetricSourceType, testBuilder.Definition.Spec.Metrics[0].Type)
+			assert.Equal(t, testCase.resourceName, testBuilder.Definition.Spec.Metrics[0].Resource.Name)
+			assert.Equal(t, testCase.averageUtilization,
+				*testBuilder.Definition.Spec.Metrics[0].Resource.Target.AverageUtilization)
+		}
+	}
+}
+
+func TestHPAWithPodsMetric(t *testing.T) {
+	testCases := []struct {
+		metricName    string
+		averageValue  resource.Quantity
+		expectedError string
+	}{
+		{
+			metricName:    "requests_per_second",
+			averageValue:  resource.MustParse("10"),
+			expectedError: "",
+		},
+		{
+			metricName:    "",
+			averageValue:  resource.MustParse("10"),
+			expectedError: "horizontalpodautoscaler pods metric 'metricName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).
+			WithPodsMetric(testCase.metricName, testCase.averageValue)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Len(t, testBuilder.Definition.Spec.Metrics, 1)
+			assert.Equal(t, autoscalingv2.PodsMetricSourceType, testBuilder.Definition.Spec.Metrics[0].Type)
+			assert.Equal(t, testCase.metricName, testBuilder.Definition.Spec.Metrics[0].Pods.Metric.Name)
+			assert.Equal(t, testCase.averageValue, *testBuilder.Definition.Spec.Metrics[0].Pods.Target.AverageValue)
+		}
+	}
+}
+
+func TestHPAWithExternalMetric(t *testing.T) {
+	testCases := []struct {
+		metricName    string
+		target        autoscalingv2.MetricTarget
+		expectedError string
+	}{
+		{
+			metricName: "queue_length",
+			target: autoscalingv2.MetricTarget{
+				Type:  autoscalingv2.ValueMetricType,
+				Value: ptr.To(resource.MustParse("30")),
+			},
+			expectedError: "",
+		},
+		{
+			metricName: "queue_length",
+			target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: ptr.To(resource.MustParse("5")),
+			},
+			expectedError: "",
+		},
+		{
+			metricName: "",
+			target: autoscalingv2.MetricTarget{
+				Type:  autoscalingv2.ValueMetricType,
+				Value: ptr.To(resource.MustParse("30")),
+			},
+			expectedError: "horizontalpodautoscaler external metric 'metricName' cannot be empty",
+		},
+		{
+			metricName:    "queue_length",
+			target:        autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType},
+			expectedError: "horizontalpodautoscaler external metric 'target' must set a Value or an AverageValue",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).
+			WithExternalMetric(testCase.metricName, nil, testCase.target)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Len(t, testBuilder.Definition.Spec.Metrics, 1)
+			assert.Equal(t, autoscalingv2.ExternalMetricSourceType, testBuilder.Definition.Spec.Metrics[0].Type)
+			assert.Equal(t, testCase.metricName, testBuilder.Definition.Spec.Metrics[0].External.Metric.Name)
+			assert.Equal(t, testCase.target, testBuilder.Definition.Spec.Metrics[0].External.Target)
+		}
+	}
+}
+
+func TestHPAWithScaleUpBehavior(t *testing.T) {
+	testCases := []struct {
+		rules         autoscalingv2.HPAScalingRules
+		expectedError string
+	}{
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptr.To(int32(0)),
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PodsScalingPolicy, Value: 4, PeriodSeconds: 15},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.To(int32(3601))},
+			expectedError: "horizontalpodautoscaler 'scaleUp' behavior is invalid: " +
+				"stabilizationWindowSeconds must be between 0 and 3600",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				Policies: []autoscalingv2.HPAScalingPolicy{{Type: "Nodes", Value: 1, PeriodSeconds: 15}},
+			},
+			expectedError: "horizontalpodautoscaler 'scaleUp' behavior is invalid: policy type Nodes is not supported",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PodsScalingPolicy, Value: 0, PeriodSeconds: 15},
+				},
+			},
+			expectedError: "horizontalpodautoscaler 'scaleUp' behavior is invalid: policy value must be at least 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).WithScaleUpBehavior(testCase.rules)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.rules, *testBuilder.Definition.Spec.Behavior.ScaleUp)
+			assert.Nil(t, testBuilder.Definition.Spec.Behavior.ScaleDown)
+		}
+	}
+}
+
+func TestHPAWithScaleDownBehavior(t *testing.T) {
+	testCases := []struct {
+		rules         autoscalingv2.HPAScalingRules
+		expectedError string
+	}{
+		{
+			rules:         autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.To(int32(300))},
+			expectedError: "",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PercentScalingPolicy, Value: 10, PeriodSeconds: 0},
+				},
+			},
+			expectedError: "horizontalpodautoscaler 'scaleDown' behavior is invalid: " +
+				"policy periodSeconds must be between 1 and 1800",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).WithScaleDownBehavior(testCase.rules)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.rules, *testBuilder.Definition.Spec.Behavior.ScaleDown)
+			assert.Nil(t, testBuilder.Definition.Spec.Behavior.ScaleUp)
+		}
+	}
+}
+
+func TestHPAWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    AdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				builder.Definition.Spec.MinReplicas = ptr.To(int32(2))
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(buildHPAClientWithDummyObject()).WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, int32(2), *testBuilder.Definition.Spec.MinReplicas)
+		}
+	}
+}
+
+func TestHPAExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestHPACreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithResourceMetric(corev1.ResourceCPU, 50),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: fmt.Errorf("horizontalpodautoscaler 'maxReplicas' must be at least 1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestHPAUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf(
+				"horizontalpodautoscaler object test-hpa does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: fmt.Errorf("horizontalpodautoscaler 'maxReplicas' must be at least 1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithMinReplicas(2).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, int32(2), *testBuilder.Object.Spec.MinReplicas)
+		}
+	}
+}
+
+func TestHPADelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: fmt.Errorf("horizontalpodautoscaler 'maxReplicas' must be at least 1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestHPAWaitUntilDesiredReplicas(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		replicas      int32
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			replicas:      2,
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			replicas:      3,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder: buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})),
+			replicas:    2,
+			expectedError: fmt.Errorf(
+				"cannot wait for horizontalpodautoscaler test-hpa which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitUntilDesiredReplicas(testCase.replicas, time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestHPAWaitForScaleEvent(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder: buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf(
+				"cannot wait for horizontalpodautoscaler test-hpa which does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidHPABuilder(buildHPAClientWithDummyObject()),
+			expectedError: fmt.Errorf("horizontalpodautoscaler 'maxReplicas' must be at least 1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitForScaleEvent(time.Second)
+		assert.EqualError(t, err, testCase.expectedError.Error())
+	}
+}
+
+func TestHPAIsScalingActive(t *testing.T) {
+	testCases := []struct {
+		conditionStatus corev1.ConditionStatus
+		exists          bool
+		expectedActive  bool
+		expectedError   error
+	}{
+		{
+			conditionStatus: corev1.ConditionTrue,
+			exists:          true,
+			expectedActive:  true,
+			expectedError:   nil,
+		},
+		{
+			conditionStatus: corev1.ConditionFalse,
+			exists:          true,
+			expectedActive:  false,
+			expectedError:   nil,
+		},
+		{
+			conditionStatus: "",
+			exists:          true,
+			expectedActive:  false,
+			expectedError:   nil,
+		},
+		{
+			exists: false,
+			expectedError: fmt.Errorf(
+				"horizontalpodautoscaler object test-hpa does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			hpa := buildDummyHPAWithName(defaultHPAName)
+
+			if testCase.conditionStatus != "" {
+				hpa.Status.Conditions = []autoscalingv2.HorizontalPodAutoscalerCondition{{
+					Type:   autoscalingv2.ScalingActive,
+					Status: testCase.conditionStatus,
+				}}
+			}
+
+			runtimeObjects = append(runtimeObjects, hpa)
+		}
+
+		testBuilder := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		active, err := testBuilder.IsScalingActive()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedActive, active)
+	}
+}
+
+func TestHPAValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil HorizontalPodAutoscaler builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined HorizontalPodAutoscaler",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "HorizontalPodAutoscaler builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidHPABuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultHPAName, defaultHPANamespace, defaultHPATarget, defaultHPAMaxReplicas)
+}
+
+func buildInvalidHPABuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultHPAName, defaultHPANamespace, defaultHPATarget, 0)
+}
+
+func buildHPAClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyHPA(),
+	})
+}
+
+func buildDummyHPA() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyHPAWithName(defaultHPAName))
+}
+
+func buildDummyHPAWithName(name string) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultHPANamespace,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: defaultHPATarget,
+			MaxReplicas:    defaultHPAMaxReplicas,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 2,
+			DesiredReplicas: 2,
+			LastScaleTime:   &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		},
+	}
+}
@@ -0,0 +1,463 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	netv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var retryInterval = time.Second * 3
+
+// IngressBuilder provides a struct for an ingress object from the cluster and an ingress definition.
+type IngressBuilder struct {
+	// ingress definition, used to create the ingress object.
+	Definition *netv1.Ingress
+	// Created ingress object.
+	Object *netv1.Ingress
+	// Used to store latest error message upon defining or mutating ingress definition.
+	errorMsg string
+	// api clients to interact with the cluster.
+	apiClient *clients.Settings
+}
+
+// IngressAdditionalOptions additional options for ingress object.
+type IngressAdditionalOptions func(builder *IngressBuilder) (*IngressBuilder, error)
+
+// NewIngressBuilder creates a new instance of IngressBuilder. Rules, TLS and the ingress class are added with the
+// With functions.
+func NewIngressBuilder(apiClient *clients.Settings, name, nsname string) *IngressBuilder {
+	glog.V(100).Infof(
+		"Initializing new ingress structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("ingress 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &IngressBuilder{
+		apiClient: apiClient,
+		Definition: &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the ingress is empty")
+
+		builder.errorMsg = "ingress 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the ingress is empty")
+
+		builder.errorMsg = "ingress 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// PullIngress loads an existing ingress into IngressBuilder struct.
+func PullIngress(apiClient *clients.Settings, name, nsname string) (*IngressBuilder, error) {
+	glog.V(100).Infof("Pulling existing ingress %s in namespace %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("ingress 'apiClient' cannot be empty")
+	}
+
+	builder := &IngressBuilder{
+		apiClient: apiClient,
+		Definition: &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The ingress name is empty")
+
+		return nil, fmt.Errorf("ingress 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The ingress namespace is empty")
+
+		return nil, fmt.Errorf("ingress 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ingress object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithIngressClassName sets the IngressClass implementing the ingress.
+func (builder *IngressBuilder) WithIngressClassName(className string) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting ingressClassName %s in ingress %s in namespace %s",
+		className, builder.Definition.Name, builder.Definition.Namespace)
+
+	if className == "" {
+		builder.errorMsg = "ingress 'ingressClassName' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.IngressClassName = &className
+
+	return builder
+}
+
+// WithRule routes the requests for the host and path to the port of the service. Rules for the same host are merged.
+// An empty host matches all the hosts.
+func (builder *IngressBuilder) WithRule(
+	host, path string, pathType netv1.PathType, serviceName string, servicePort int32) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding rule for host %s and path %s to service %s:%d in ingress %s in namespace %s",
+		host, path, serviceName, servicePort, builder.Definition.Name, builder.Definition.Namespace)
+
+	if path == "" || path[0] != '/' {
+		builder.errorMsg = fmt.Sprintf("ingress rule path %s must be an absolute path", path)
+
+		return builder
+	}
+
+	switch pathType {
+	case netv1.PathTypeExact, netv1.PathTypePrefix, netv1.PathTypeImplementationSpecific:
+	default:
+		builder.errorMsg = fmt.Sprintf("ingress rule pathType %s is not supported", pathType)
+
+		return builder
+	}
+
+	backend, err := newServiceBackend(serviceName, servicePort)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("ingress rule backend is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	httpPath := netv1.HTTPIngressPath{Path: path, PathType: &pathType, Backend: backend}
+
+	for index, rule := range builder.Definition.Spec.Rules {
+		if rule.Host == host && rule.HTTP != nil {
+			builder.Definition.Spec.Rules[index].HTTP.Paths = append(rule.HTTP.Paths, httpPath)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, netv1.IngressRule{
+		Host: host,
+		IngressRuleValue: netv1.IngressRuleValue{
+			HTTP: &netv1.HTTPIngressRuleValue{Paths: []netv1.HTTPIngressPath{httpPath}},
+		},
+	})
+
+	return builder
+}
+
+// WithDefaultBackend sets the service handling the requests that match no rule.
+func (builder *IngressBuilder) WithDefaultBackend(serviceName string, servicePort int32) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting default backend %s:%d in ingress %s in namespace %s",
+		serviceName, servicePort, builder.Definition.Name, builder.Definition.Namespace)
+
+	backend, err := newServiceBackend(serviceName, servicePort)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("ingress default backend is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.DefaultBackend = &backend
+
+	return builder
+}
+
+// WithTLS terminates TLS for the given hosts using the certificate stored in the secret.
+func (builder *IngressBuilder) WithTLS(secretName string, hosts ...string) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding TLS with secret %s for hosts %v to ingress %s in namespace %s",
+		secretName, hosts, builder.Definition.Name, builder.Definition.Namespace)
+
+	if secretName == "" {
+		builder.errorMsg = "ingress TLS 'secretName' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.TLS = append(builder.Definition.Spec.TLS, netv1.IngressTLS{
+		Hosts:      hosts,
+		SecretName: secretName,
+	})
+
+	return builder
+}
+
+// WithOptions creates ingress with generic mutation options.
+func (builder *IngressBuilder) WithOptions(options ...IngressAdditionalOptions) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting ingress additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Get fetches existing ingress from cluster.
+func (builder *IngressBuilder) Get() (*netv1.Ingress, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Fetching existing ingress with name %s under namespace %s from cluster",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.apiClient.K8sClient.NetworkingV1().Ingresses(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+}
+
+// Exists checks whether the given ingress exists.
+func (builder *IngressBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if ingress %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an ingress in cluster and stores the created object in struct.
+func (builder *IngressBuilder) Create() (*IngressBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating the ingress %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.Rules) == 0 && builder.Definition.Spec.DefaultBackend == nil {
+		return builder, fmt.Errorf("ingress %s must have at least one rule or a default backend",
+			builder.Definition.Name)
+	}
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.NetworkingV1().Ingresses(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing ingress object with the ingress definition in builder.
+func (builder *IngressBuilder) Update() (*IngressBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the ingress %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("ingress object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.NetworkingV1().Ingresses(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes an ingress.
+func (builder *IngressBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting the ingress %s from namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.NetworkingV1().Ingresses(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot delete ingress: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetAddresses returns the IPs and hostnames published in the ingress status by the ingress controller.
+func (builder *IngressBuilder) GetAddresses() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting addresses of ingress %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ingress object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var addresses []string
+
+	for _, ingress := range builder.Object.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			addresses = append(addresses, ingress.IP)
+		}
+
+		if ingress.Hostname != "" {
+			addresses = append(addresses, ingress.Hostname)
+		}
+	}
+
+	return addresses, nil
+}
+
+// WaitUntilAddressAssigned waits for the duration of the defined timeout or until the ingress controller publishes
+// at least one address in the ingress status, and returns the addresses.
+func (builder *IngressBuilder) WaitUntilAddressAssigned(timeout time.Duration) ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until ingress %s in namespace %s gets an address",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var addresses []string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			addresses, err = builder.GetAddresses()
+			if err != nil {
+				return false, nil
+			}
+
+			return len(addresses) > 0, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// newServiceBackend returns an ingress backend pointing to the port of the service.
+func newServiceBackend(serviceName string, servicePort int32) (netv1.IngressBackend, error) {
+	if serviceName == "" {
+		return netv1.IngressBackend{}, fmt.Errorf("'serviceName' cannot be empty")
+	}
+
+	if servicePort < 1 || servicePort > 65535 {
+		return netv1.IngressBackend{}, fmt.Errorf("'servicePort' %d is out of range", servicePort)
+	}
+
+	return netv1.IngressBackend{
+		Service: &netv1.IngressServiceBackend{
+			Name: serviceName,
+			Port: netv1.ServiceBackendPort{Number: servicePort},
+		},
+	}, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *IngressBuilder) validate() (bool, error) {
+	resourceCRD := "Ingress"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiClient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,674 @@
+package ingress
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultIngressName      = "test-ingress"
+	defaultIngressNamespace = "test-namespace"
+)
+
+func TestNewIngressBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultIngressName,
+			namespace:     defaultIngressNamespace,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultIngressNamespace,
+			client:        true,
+			expectedError: "ingress 'name' cannot be empty",
+		},
+		{
+			name:          defaultIngressName,
+			namespace:     "",
+			client:        true,
+			expectedError: "ingress 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultIngressName,
+			namespace:     defaultIngressNamespace,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewIngressBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullIngress(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultIngressName,
+			namespace:           defaultIngressNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultIngressName,
+			namespace:           defaultIngressNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("ingress object test-ingress does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultIngressNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("ingress 'name' cannot be empty"),
+		},
+		{
+			name:                defaultIngressName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("ingress 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultIngressName,
+			namespace:           defaultIngressNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("ingress 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIngress()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := PullIngress(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestIngressBuilderWithIngressClassName(t *testing.T) {
+	testCases := []struct {
+		className     string
+		expectedError string
+	}{
+		{
+			className:     "openshift-default",
+			expectedError: "",
+		},
+		{
+			className:     "",
+			expectedError: "ingress 'ingressClassName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(buildIngressClientWithDummyObject()).
+			WithIngressClassName(testCase.className)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.className, *testBuilder.Definition.Spec.IngressClassName)
+		}
+	}
+}
+
+func TestIngressBuilderWithRule(t *testing.T) {
+	testCases := []struct {
+		host          string
+		path          string
+		pathType      netv1.PathType
+		serviceName   string
+		servicePort   int32
+		expectedRules int
+		expectedPaths int
+		expectedError string
+	}{
+		{
+			host:          "app.example.com",
+			path:          "/api",
+			pathType:      netv1.PathTypePrefix,
+			serviceName:   "backend",
+			servicePort:   9090,
+			expectedRules: 1,
+			expectedPaths: 2,
+			expectedError: "",
+		},
+		{
+			host:          "",
+			path:          "/health",
+			pathType:      netv1.PathTypeExact,
+			serviceName:   "health",
+			servicePort:   80,
+			expectedRules: 2,
+			expectedPaths: 1,
+			expectedError: "",
+		},
+		{
+			host:          "",
+			path:          "api",
+			pathType:      netv1.PathTypePrefix,
+			serviceName:   "backend",
+			servicePort:   80,
+			expectedError: "ingress rule path api must be an absolute path",
+		},
+		{
+			host:          "",
+			path:          "/",
+			pathType:      "Regex",
+			serviceName:   "backend",
+			servicePort:   80,
+			expectedError: "ingress rule pathType Regex is not supported",
+		},
+		{
+			host:          "",
+			path:          "/",
+			pathType:      netv1.PathTypePrefix,
+			serviceName:   "",
+			servicePort:   80,
+			expectedError: "ingress rule backend is invalid: 'serviceName' cannot be empty",
+		},
+		{
+			host:          "",
+			path:          "/",
+			pathType:      netv1.PathTypePrefix,
+			serviceName:   "backend",
+			servicePort:   70000,
+			expectedError: "ingress rule backend is invalid: 'servicePort' 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(buildIngressClientWithDummyObject()).
+			WithRule("app.example.com", "/", netv1.PathTypePrefix, "frontend", 8080).
+			WithRule(testCase.host, testCase.path, testCase.pathType, testCase.serviceName, testCase.servicePort)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			rules := testBuilder.Definition.Spec.Rules
+			assert.Len(t, rules, testCase.expectedRules)
+
+			lastRule := rules[len(rules)-1]
+			assert.Equal(t, testCase.host, lastRule.Host)
+			assert.Len(t, lastRule.HTTP.Paths, testCase.expectedPaths)
+
+			lastPath := lastRule.HTTP.Paths[len(lastRule.HTTP.Paths)-1]
+			assert.Equal(t, testCase.path, lastPath.Path)
+			assert.Equal(t, testCase.pathType, *lastPath.PathType)
+			assert.Equal(t, testCase.serviceName, lastPath.Backend.Service.Name)
+			assert.Equal(t, testCase.servicePort, lastPath.Backend.Service.Port.Number)
+		}
+	}
+}
+
+func TestIngressBuilderWithDefaultBackend(t *testing.T) {
+	testCases := []struct {
+		serviceName   string
+		servicePort   int32
+		expectedError string
+	}{
+		{
+			serviceName:   "backend",
+			servicePort:   8080,
+			expectedError: "",
+		},
+		{
+			serviceName:   "",
+			servicePort:   8080,
+			expectedError: "ingress default backend is invalid: 'serviceName' cannot be empty",
+		},
+		{
+			serviceName:   "backend",
+			servicePort:   0,
+			expectedError: "ingress default backend is invalid: 'servicePort' 0 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(buildIngressClientWithDummyObject()).
+			WithDefaultBackend(testCase.serviceName, testCase.servicePort)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.serviceName, testBuilder.Definition.Spec.DefaultBackend.Service.Name)
+			assert.Equal(t, testCase.servicePort, testBuilder.Definition.Spec.DefaultBackend.Service.Port.Number)
+		}
+	}
+}
+
+func TestIngressBuilderWithTLS(t *testing.T) {
+	testCases := []struct {
+		secretName    string
+		hosts         []string
+		expectedError string
+	}{
+		{
+			secretName:    "app-cert",
+			hosts:         []string{"app.example.com"},
+			expectedError: "",
+		},
+		{
+			secretName:    "wildcard-cert",
+			hosts:         nil,
+			expectedError: "",
+		},
+		{
+			secretName:    "",
+			hosts:         []string{"app.example.com"},
+			expectedError: "ingress TLS 'secretName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(buildIngressClientWithDummyObject()).
+			WithTLS(testCase.secretName, testCase.hosts...)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []netv1.IngressTLS{{Hosts: testCase.hosts, SecretName: testCase.secretName}},
+				testBuilder.Definition.Spec.TLS)
+		}
+	}
+}
+
+func TestIngressBuilderWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    IngressAdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *IngressBuilder) (*IngressBuilder, error) {
+				builder.Definition.Labels = map[string]string{"app": "test"}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *IngressBuilder) (*IngressBuilder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(buildIngressClientWithDummyObject()).WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+		}
+	}
+}
+
+func TestIngressBuilderExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *IngressBuilder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestIngressBuilderCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *IngressBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithDefaultBackend("backend", 8080),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithRule("app.example.com", "/", netv1.PathTypePrefix, "frontend", 80),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidIngressBuilder(buildIngressClientWithDummyObject()).
+				WithDefaultBackend("backend", 8080),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf("ingress test-ingress must have at least one rule or a default backend"),
+		},
+		{
+			testBuilder:   buildInvalidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedError: fmt.Errorf("ingress 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestIngressBuilderUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *IngressBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf("ingress object test-ingress does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedError: fmt.Errorf("ingress 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.
+			WithRule("app.example.com", "/", netv1.PathTypePrefix, "frontend", 80).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, testBuilder.Object.Spec.Rules, 1)
+		}
+	}
+}
+
+func TestIngressBuilderDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *IngressBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidIngressBuilder(buildIngressClientWithDummyObject()),
+			expectedError: fmt.Errorf("ingress 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestIngressBuilderGetAddresses(t *testing.T) {
+	testCases := []struct {
+		loadBalancerIngress []netv1.IngressLoadBalancerIngress
+		exists              bool
+		expectedAddresses   []string
+		expectedError       error
+	}{
+		{
+			loadBalancerIngress: []netv1.IngressLoadBalancerIngress{
+				{IP: "192.168.10.1"}, {Hostname: "router-default.apps.example.com"}},
+			exists:            true,
+			expectedAddresses: []string{"192.168.10.1", "router-default.apps.example.com"},
+			expectedError:     nil,
+		},
+		{
+			loadBalancerIngress: nil,
+			exists:              true,
+			expectedAddresses:   nil,
+			expectedError:       nil,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("ingress object test-ingress does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyIngressWithAddresses(testCase.loadBalancerIngress))
+		}
+
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		addresses, err := testBuilder.GetAddresses()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAddresses, addresses)
+	}
+}
+
+func TestIngressBuilderWaitUntilAddressAssigned(t *testing.T) {
+	testCases := []struct {
+		loadBalancerIngress []netv1.IngressLoadBalancerIngress
+		exists              bool
+		expectedAddresses   []string
+		expectedError       error
+	}{
+		{
+			loadBalancerIngress: []netv1.IngressLoadBalancerIngress{{IP: "192.168.10.1"}},
+			exists:              true,
+			expectedAddresses:   []string{"192.168.10.1"},
+			expectedError:       nil,
+		},
+		{
+			loadBalancerIngress: nil,
+			exists:              true,
+			expectedError:       fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyIngressWithAddresses(testCase.loadBalancerIngress))
+		}
+
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		addresses, err := testBuilder.WaitUntilAddressAssigned(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+
+		assert.Equal(t, testCase.expectedAddresses, addresses)
+	}
+}
+
+func TestIngressBuilderValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil Ingress builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined Ingress",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "Ingress builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidIngressBuilder(apiClient *clients.Settings) *IngressBuilder {
+	return NewIngressBuilder(apiClient, defaultIngressName, defaultIngressNamespace)
+}
+
+func buildInvalidIngressBuilder(apiClient *clients.Settings) *IngressBuilder {
+	return NewIngressBuilder(apiClient, defaultIngressName, "")
+}
+
+func buildIngressClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyIngress(),
+	})
+}
+
+func buildDummyIngress() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyIngressWithAddresses(nil))
+}
+
+func buildDummyIngressWithAddresses(loadBalancerIngress []netv1.IngressLoadBalancerIngress) *netv1.Ingress {
+	return &netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultIngressName,
+			Namespace: defaultIngressNamespace,
+		},
+		Spec: netv1.IngressSpec{
+			DefaultBackend: &netv1.IngressBackend{
+				Service: &netv1.IngressServiceBackend{
+					Name: "backend",
+					Port: netv1.ServiceBackendPort{Number: 8080},
+				},
+			},
+		},
+		Status: netv1.IngressStatus{
+			LoadBalancer: netv1.IngressLoadBalancerStatus{Ingress: loadBalancerIngress},
+		},
+	}
+}
@@ -0,0 +1,176 @@
+// Package scheduling provides the pod spec mutations behind the scheduling options shared by the pod, deployment,
+// daemonset and statefulset builders.
+package scheduling
+
+import (
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SetNodeAffinity sets the node affinity of the pod spec, keeping the other affinity settings.
+func SetNodeAffinity(podSpec *corev1.PodSpec, nodeAffinity *corev1.NodeAffinity) error {
+	if nodeAffinity == nil {
+		return fmt.Errorf("'nodeAffinity' cannot be empty")
+	}
+
+	affinity(podSpec).NodeAffinity = nodeAffinity
+
+	return nil
+}
+
+// SetPodAffinity sets the pod affinity of the pod spec, keeping the other affinity settings.
+func SetPodAffinity(podSpec *corev1.PodSpec, podAffinity *corev1.PodAffinity) error {
+	if podAffinity == nil {
+		return fmt.Errorf("'podAffinity' cannot be empty")
+	}
+
+	for _, term := range podAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if err := validateAffinityTerm(term); err != nil {
+			return fmt.Errorf("'podAffinity' is invalid: %w", err)
+		}
+	}
+
+	for _, weightedTerm := range podAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		if err := validateWeightedAffinityTerm(weightedTerm); err != nil {
+			return fmt.Errorf("'podAffinity' is invalid: %w", err)
+		}
+	}
+
+	affinity(podSpec).PodAffinity = podAffinity
+
+	return nil
+}
+
+// SetPodAntiAffinity sets the pod anti-affinity of the pod spec, keeping the other affinity settings.
+func SetPodAntiAffinity(podSpec *corev1.PodSpec, podAntiAffinity *corev1.PodAntiAffinity) error {
+	if podAntiAffinity == nil {
+		return fmt.Errorf("'podAntiAffinity' cannot be empty")
+	}
+
+	for _, term := range podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if err := validateAffinityTerm(term); err != nil {
+			return fmt.Errorf("'podAntiAffinity' is invalid: %w", err)
+		}
+	}
+
+	for _, weightedTerm := range podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		if err := validateWeightedAffinityTerm(weightedTerm); err != nil {
+			return fmt.Errorf("'podAntiAffinity' is invalid: %w", err)
+		}
+	}
+
+	affinity(podSpec).PodAntiAffinity = podAntiAffinity
+
+	return nil
+}
+
+// AddTopologySpreadConstraint appends the constraint to the topology spread constraints of the pod spec.
+func AddTopologySpreadConstraint(podSpec *corev1.PodSpec, constraint corev1.TopologySpreadConstraint) error {
+	if constraint.MaxSkew < 1 {
+		return fmt.Errorf("'topologySpreadConstraint' maxSkew must be at least 1")
+	}
+
+	if constraint.TopologyKey == "" {
+		return fmt.Errorf("'topologySpreadConstraint' topologyKey cannot be empty")
+	}
+
+	if constraint.WhenUnsatisfiable != corev1.DoNotSchedule && constraint.WhenUnsatisfiable != corev1.ScheduleAnyway {
+		return fmt.Errorf("'topologySpreadConstraint' whenUnsatisfiable %s is not supported",
+			constraint.WhenUnsatisfiable)
+	}
+
+	podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, constraint)
+
+	return nil
+}
+
+// SetPriorityClassName sets the priority class of the pod spec.
+func SetPriorityClassName(podSpec *corev1.PodSpec, priorityClassName string) error {
+	if priorityClassName == "" {
+		return fmt.Errorf("'priorityClassName' cannot be empty")
+	}
+
+	podSpec.PriorityClassName = priorityClassName
+
+	return nil
+}
+
+// SetRuntimeClassName sets the runtime class of the pod spec.
+func SetRuntimeClassName(podSpec *corev1.PodSpec, runtimeClassName string) error {
+	if runtimeClassName == "" {
+		return fmt.Errorf("'runtimeClassName' cannot be empty")
+	}
+
+	podSpec.RuntimeClassName = &runtimeClassName
+
+	return nil
+}
+
+// SetDNSConfig sets the DNS policy and DNS config of the pod spec. The None policy requires a config with at least
+// one nameserver, other policies accept a nil config.
+func SetDNSConfig(podSpec *corev1.PodSpec, dnsPolicy corev1.DNSPolicy, dnsConfig *corev1.PodDNSConfig) error {
+	switch dnsPolicy {
+	case corev1.DNSClusterFirstWithHostNet, corev1.DNSClusterFirst, corev1.DNSDefault:
+	case corev1.DNSNone:
+		if dnsConfig == nil || len(dnsConfig.Nameservers) == 0 {
+			return fmt.Errorf("'dnsConfig' must have at least one nameserver when 'dnsPolicy' is None")
+		}
+	default:
+		return fmt.Errorf("'dnsPolicy' %s is not supported", dnsPolicy)
+	}
+
+	if dnsConfig != nil {
+		for _, nameserver := range dnsConfig.Nameservers {
+			if net.ParseIP(nameserver) == nil {
+				return fmt.Errorf("'dnsConfig' nameserver %s is not a valid IP address", nameserver)
+			}
+		}
+	}
+
+	podSpec.DNSPolicy = dnsPolicy
+	podSpec.DNSConfig = dnsConfig
+
+	return nil
+}
+
+// AddHostAlias appends the host alias to the /etc/hosts entries of the pod spec.
+func AddHostAlias(podSpec *corev1.PodSpec, hostAlias corev1.HostAlias) error {
+	if net.ParseIP(hostAlias.IP) == nil {
+		return fmt.Errorf("'hostAlias' ip %s is not a valid IP address", hostAlias.IP)
+	}
+
+	if len(hostAlias.Hostnames) == 0 {
+		return fmt.Errorf("'hostAlias' hostnames cannot be empty")
+	}
+
+	podSpec.HostAliases = append(podSpec.HostAliases, hostAlias)
+
+	return nil
+}
+
+// affinity returns the affinity of the pod spec, initializing it if needed.
+func affinity(podSpec *corev1.PodSpec) *corev1.Affinity {
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+
+	return podSpec.Affinity
+}
+
+func validateAffinityTerm(term corev1.PodAffinityTerm) error {
+	if term.TopologyKey == "" {
+		return fmt.Errorf("topologyKey cannot be empty")
+	}
+
+	return nil
+}
+
+func validateWeightedAffinityTerm(weightedTerm corev1.WeightedPodAffinityTerm) error {
+	if weightedTerm.Weight < 1 || weightedTerm.Weight > 100 {
+		return fmt.Errorf("weight must be between 1 and 100")
+	}
+
+	return validateAffinityTerm(weightedTerm.PodAffinityTerm)
+}
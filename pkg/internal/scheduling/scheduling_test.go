@@ -0,0 +1,153 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetAffinities(t *testing.T) {
+	podSpec := &corev1.PodSpec{}
+
+	nodeAffinity := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key:      "node-role.kubernetes.io/worker",
+					Operator: corev1.NodeSelectorOpExists,
+				}},
+			}},
+		},
+	}
+
+	assert.Nil(t, SetNodeAffinity(podSpec, nodeAffinity))
+	assert.Nil(t, SetPodAntiAffinity(podSpec, &corev1.PodAntiAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			TopologyKey:   corev1.LabelHostname,
+		}},
+	}))
+	assert.Equal(t, nodeAffinity, podSpec.Affinity.NodeAffinity)
+	assert.NotNil(t, podSpec.Affinity.PodAntiAffinity)
+
+	assert.EqualError(t, SetNodeAffinity(podSpec, nil), "'nodeAffinity' cannot be empty")
+	assert.EqualError(t, SetPodAffinity(podSpec, &corev1.PodAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{}},
+	}), "'podAffinity' is invalid: topologyKey cannot be empty")
+	assert.EqualError(t, SetPodAntiAffinity(podSpec, &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+			Weight:          101,
+			PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelHostname},
+		}},
+	}), "'podAntiAffinity' is invalid: weight must be between 1 and 100")
+}
+
+func TestAddTopologySpreadConstraint(t *testing.T) {
+	testCases := []struct {
+		constraint    corev1.TopologySpreadConstraint
+		expectedError string
+	}{
+		{
+			constraint: corev1.TopologySpreadConstraint{
+				MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule,
+			},
+		},
+		{
+			constraint: corev1.TopologySpreadConstraint{
+				MaxSkew: 0, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule,
+			},
+			expectedError: "'topologySpreadConstraint' maxSkew must be at least 1",
+		},
+		{
+			constraint:    corev1.TopologySpreadConstraint{MaxSkew: 1, WhenUnsatisfiable: corev1.ScheduleAnyway},
+			expectedError: "'topologySpreadConstraint' topologyKey cannot be empty",
+		},
+		{
+			constraint: corev1.TopologySpreadConstraint{
+				MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: "Sometimes",
+			},
+			expectedError: "'topologySpreadConstraint' whenUnsatisfiable Sometimes is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		podSpec := &corev1.PodSpec{}
+		err := AddTopologySpreadConstraint(podSpec, testCase.constraint)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+			assert.Equal(t, []corev1.TopologySpreadConstraint{testCase.constraint}, podSpec.TopologySpreadConstraints)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Empty(t, podSpec.TopologySpreadConstraints)
+		}
+	}
+}
+
+func TestSetClassNames(t *testing.T) {
+	podSpec := &corev1.PodSpec{}
+
+	assert.Nil(t, SetPriorityClassName(podSpec, "system-node-critical"))
+	assert.Nil(t, SetRuntimeClassName(podSpec, "performance-test"))
+	assert.Equal(t, "system-node-critical", podSpec.PriorityClassName)
+	assert.Equal(t, "performance-test", *podSpec.RuntimeClassName)
+
+	assert.EqualError(t, SetPriorityClassName(podSpec, ""), "'priorityClassName' cannot be empty")
+	assert.EqualError(t, SetRuntimeClassName(podSpec, ""), "'runtimeClassName' cannot be empty")
+}
+
+func TestSetDNSConfig(t *testing.T) {
+	testCases := []struct {
+		dnsPolicy     corev1.DNSPolicy
+		dnsConfig     *corev1.PodDNSConfig
+		expectedError string
+	}{
+		{
+			dnsPolicy: corev1.DNSNone,
+			dnsConfig: &corev1.PodDNSConfig{Nameservers: []string{"10.0.0.10"}, Searches: []string{"example.com"}},
+		},
+		{
+			dnsPolicy: corev1.DNSClusterFirst,
+		},
+		{
+			dnsPolicy:     corev1.DNSNone,
+			expectedError: "'dnsConfig' must have at least one nameserver when 'dnsPolicy' is None",
+		},
+		{
+			dnsPolicy:     corev1.DNSDefault,
+			dnsConfig:     &corev1.PodDNSConfig{Nameservers: []string{"dns.example.com"}},
+			expectedError: "'dnsConfig' nameserver dns.example.com is not a valid IP address",
+		},
+		{
+			dnsPolicy:     "Custom",
+			expectedError: "'dnsPolicy' Custom is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		podSpec := &corev1.PodSpec{}
+		err := SetDNSConfig(podSpec, testCase.dnsPolicy, testCase.dnsConfig)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.dnsPolicy, podSpec.DNSPolicy)
+			assert.Equal(t, testCase.dnsConfig, podSpec.DNSConfig)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+		}
+	}
+}
+
+func TestAddHostAlias(t *testing.T) {
+	podSpec := &corev1.PodSpec{}
+
+	assert.Nil(t, AddHostAlias(podSpec, corev1.HostAlias{IP: "fd00::1", Hostnames: []string{"registry.local"}}))
+	assert.Len(t, podSpec.HostAliases, 1)
+
+	assert.EqualError(t, AddHostAlias(podSpec, corev1.HostAlias{IP: "not-an-ip", Hostnames: []string{"a"}}),
+		"'hostAlias' ip not-an-ip is not a valid IP address")
+	assert.EqualError(t, AddHostAlias(podSpec, corev1.HostAlias{IP: "10.0.0.1"}),
+		"'hostAlias' hostnames cannot be empty")
+}
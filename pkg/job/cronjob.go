@@ -0,0 +1,481 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// cronJobInstantiateAnnotation marks jobs that were created manually from a cronjob, matching kubectl create job
+// --from=cronjob.
+const cronJobInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// CronJobBuilder provides struct for cronjob object containing connection to the cluster and the cronjob
+// definitions.
+type CronJobBuilder struct {
+	// CronJob definition. Used to create the cronjob object.
+	Definition *batchv1.CronJob
+	// Created cronjob object.
+	Object *batchv1.CronJob
+	// Used in functions that define or mutate cronjob definition. errorMsg is processed before the cronjob
+	// object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// CronJobAdditionalOptions additional options for cronjob object.
+type CronJobAdditionalOptions func(builder *CronJobBuilder) (*CronJobBuilder, error)
+
+// NewCronJobBuilder creates a new instance of CronJobBuilder.
+func NewCronJobBuilder(
+	apiClient *clients.Settings, name, nsname, schedule string, containerSpec *corev1.Container) *CronJobBuilder {
+	glog.V(100).Infof(
+		"Initializing new cronjob structure with the following params: "+
+			"name: %s, namespace: %s, schedule: %s, containerSpec %v",
+		name, nsname, schedule, containerSpec)
+
+	if apiClient == nil {
+		glog.V(100).Infof("cronjob 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &CronJobBuilder{
+		apiClient: apiClient,
+		Definition: &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: batchv1.CronJobSpec{
+				Schedule: schedule,
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								RestartPolicy: corev1.RestartPolicyNever,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the cronjob is empty")
+
+		builder.errorMsg = "cronjob 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the cronjob is empty")
+
+		builder.errorMsg = "cronjob 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if schedule == "" {
+		glog.V(100).Infof("The schedule of the cronjob is empty")
+
+		builder.errorMsg = "cronjob 'schedule' cannot be empty"
+
+		return builder
+	}
+
+	if containerSpec == nil {
+		glog.V(100).Infof("The containerSpec of the cronjob is empty")
+
+		builder.errorMsg = "cronjob 'containerSpec' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.JobTemplate.Spec.Template.Spec.Containers = []corev1.Container{*containerSpec}
+
+	return builder
+}
+
+// PullCronJob loads an existing cronjob into the CronJobBuilder struct.
+func PullCronJob(apiClient *clients.Settings, name, nsname string) (*CronJobBuilder, error) {
+	glog.V(100).Infof("Pulling existing cronjob name: %s under namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("cronjob 'apiClient' cannot be empty")
+	}
+
+	builder := &CronJobBuilder{
+		apiClient: apiClient,
+		Definition: &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the cronjob is empty")
+
+		return nil, fmt.Errorf("cronjob 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the cronjob is empty")
+
+		return nil, fmt.Errorf("cronjob 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cronjob object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithConcurrencyPolicy sets how concurrent executions of the cronjob are treated.
+func (builder *CronJobBuilder) WithConcurrencyPolicy(policy batchv1.ConcurrencyPolicy) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting concurrencyPolicy %s in cronjob %s in namespace %s",
+		policy, builder.Definition.Name, builder.Definition.Namespace)
+
+	switch policy {
+	case batchv1.AllowConcurrent, batchv1.ForbidConcurrent, batchv1.ReplaceConcurrent:
+	default:
+		glog.V(100).Infof("The concurrencyPolicy %s is not supported", policy)
+
+		builder.errorMsg = "cronjob 'concurrencyPolicy' must be one of Allow, Forbid or Replace"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ConcurrencyPolicy = policy
+
+	return builder
+}
+
+// WithHistoryLimits sets the number of successful and failed finished jobs to retain.
+func (builder *CronJobBuilder) WithHistoryLimits(successful, failed int32) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting history limits successful: %d, failed: %d in cronjob %s in namespace %s",
+		successful, failed, builder.Definition.Name, builder.Definition.Namespace)
+
+	if successful < 0 || failed < 0 {
+		glog.V(100).Infof("The history limits of the cronjob cannot be negative")
+
+		builder.errorMsg = "cronjob history limits cannot be negative"
+
+		return builder
+	}
+
+	builder.Definition.Spec.SuccessfulJobsHistoryLimit = &successful
+	builder.Definition.Spec.FailedJobsHistoryLimit = &failed
+
+	return builder
+}
+
+// WithStartingDeadlineSeconds sets the deadline for starting a job that missed its scheduled time.
+func (builder *CronJobBuilder) WithStartingDeadlineSeconds(deadlineSeconds int64) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting startingDeadlineSeconds %d in cronjob %s in namespace %s",
+		deadlineSeconds, builder.Definition.Name, builder.Definition.Namespace)
+
+	if deadlineSeconds <= 0 {
+		glog.V(100).Infof("The startingDeadlineSeconds of the cronjob must be positive")
+
+		builder.errorMsg = "cronjob 'startingDeadlineSeconds' must be positive"
+
+		return builder
+	}
+
+	builder.Definition.Spec.StartingDeadlineSeconds = &deadlineSeconds
+
+	return builder
+}
+
+// WithJobOptions applies job builder mutations, such as WithBackoffLimit or WithParallelism, to the job template
+// of the cronjob.
+func (builder *CronJobBuilder) WithJobOptions(options ...AdditionalOptions) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Applying job options to the job template of cronjob %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	jobBuilder := &Builder{
+		apiClient: builder.apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: builder.Definition.Spec.JobTemplate.ObjectMeta,
+			Spec:       builder.Definition.Spec.JobTemplate.Spec,
+		},
+	}
+
+	jobBuilder = jobBuilder.WithOptions(options...)
+	if _, err := jobBuilder.validate(); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.Definition.Spec.JobTemplate.ObjectMeta = jobBuilder.Definition.ObjectMeta
+	builder.Definition.Spec.JobTemplate.Spec = jobBuilder.Definition.Spec
+
+	return builder
+}
+
+// WithOptions creates cronjob with generic mutation options.
+func (builder *CronJobBuilder) WithOptions(options ...CronJobAdditionalOptions) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting cronjob additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a cronjob in the cluster and stores the created object in struct.
+func (builder *CronJobBuilder) Create() (*CronJobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating cronjob %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing cronjob object with the cronjob definition in builder.
+func (builder *CronJobBuilder) Update() (*CronJobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating cronjob %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the cronjob together with the jobs it owns.
+func (builder *CronJobBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting cronjob %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+
+	err := builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Exists checks whether the given cronjob exists.
+func (builder *CronJobBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if cronjob %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Suspend stops the cronjob from scheduling new jobs. Jobs that are already running are not affected.
+func (builder *CronJobBuilder) Suspend() (*CronJobBuilder, error) {
+	return builder.setSuspend(true)
+}
+
+// Resume allows a suspended cronjob to schedule new jobs again.
+func (builder *CronJobBuilder) Resume() (*CronJobBuilder, error) {
+	return builder.setSuspend(false)
+}
+
+// Trigger creates a job from the job template of the cronjob, equivalent to kubectl create job --from=cronjob.
+// The job is owned by the cronjob and a builder for it is returned.
+func (builder *CronJobBuilder) Trigger(jobName string) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Triggering job %s from cronjob %s in namespace %s",
+		jobName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if jobName == "" {
+		glog.V(100).Infof("The name of the triggered job is empty")
+
+		return nil, fmt.Errorf("job 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cannot trigger job from cronjob %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	jobTemplate := builder.Object.Spec.JobTemplate.DeepCopy()
+
+	annotations := map[string]string{cronJobInstantiateAnnotation: "manual"}
+	for key, value := range jobTemplate.Annotations {
+		annotations[key] = value
+	}
+
+	jobBuilder := &Builder{
+		apiClient: builder.apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        jobName,
+				Namespace:   builder.Definition.Namespace,
+				Labels:      jobTemplate.Labels,
+				Annotations: annotations,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: batchv1.SchemeGroupVersion.String(),
+					Kind:       "CronJob",
+					Name:       builder.Object.Name,
+					UID:        builder.Object.UID,
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: jobTemplate.Spec,
+		},
+	}
+
+	return jobBuilder.Create()
+}
+
+// GetCronJobGVR returns cronjob's GroupVersionResource which could be used for Clean function.
+func GetCronJobGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+}
+
+func (builder *CronJobBuilder) setSuspend(suspend bool) (*CronJobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Setting suspend to %t on cronjob %s in namespace %s",
+		suspend, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cronjob object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	// Only suspend is changed, on a copy of the live cronjob, so that pending definition changes are neither applied
+	// nor lost.
+	cronJob := builder.Object.DeepCopy()
+	cronJob.Spec.Suspend = ptr.To(suspend)
+
+	updatedCronJob, err := builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Update(
+		context.TODO(), cronJob, metav1.UpdateOptions{})
+	if err != nil {
+		return builder, fmt.Errorf("failed to set suspend to %t on cronjob %s in namespace %s: %w",
+			suspend, builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = updatedCronJob
+	builder.Definition.Spec.Suspend = ptr.To(suspend)
+	builder.Definition.ResourceVersion = updatedCronJob.ResourceVersion
+
+	return builder, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *CronJobBuilder) validate() (bool, error) {
+	resourceCRD := "CronJob"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
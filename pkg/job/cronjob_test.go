@@ -0,0 +1,192 @@
+package job
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultCronJobName     = "test-cronjob"
+	defaultCronJobSchedule = "*/5 * * * *"
+)
+
+func TestNewCronJobBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		schedule      string
+		containerSpec *corev1.Container
+		expectedError string
+	}{
+		{
+			name:          defaultCronJobName,
+			namespace:     defaultJobNamespace,
+			schedule:      defaultCronJobSchedule,
+			containerSpec: buildTestContainer(),
+		},
+		{
+			name:          "",
+			namespace:     defaultJobNamespace,
+			schedule:      defaultCronJobSchedule,
+			containerSpec: buildTestContainer(),
+			expectedError: "cronjob 'name' cannot be empty",
+		},
+		{
+			name:          defaultCronJobName,
+			namespace:     "",
+			schedule:      defaultCronJobSchedule,
+			containerSpec: buildTestContainer(),
+			expectedError: "cronjob 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultCronJobName,
+			namespace:     defaultJobNamespace,
+			schedule:      "",
+			containerSpec: buildTestContainer(),
+			expectedError: "cronjob 'schedule' cannot be empty",
+		},
+		{
+			name:          defaultCronJobName,
+			namespace:     defaultJobNamespace,
+			schedule:      defaultCronJobSchedule,
+			expectedError: "cronjob 'containerSpec' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewCronJobBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			testCase.name, testCase.namespace, testCase.schedule, testCase.containerSpec)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+	}
+}
+
+func TestPullCronJob(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("cronjob object test-cronjob does not exist in namespace test-ns"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildTestCronJob())
+		}
+
+		testBuilder, err := PullCronJob(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}),
+			defaultCronJobName, defaultJobNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultCronJobSchedule, testBuilder.Definition.Spec.Schedule)
+		}
+	}
+}
+
+func TestCronJobWithSettings(t *testing.T) {
+	testBuilder := buildValidCronJobTestBuilder(nil).
+		WithConcurrencyPolicy(batchv1.ForbidConcurrent).
+		WithHistoryLimits(1, 2).
+		WithStartingDeadlineSeconds(30).
+		WithJobOptions(func(builder *Builder) (*Builder, error) {
+			return builder.WithBackoffLimit(3), nil
+		})
+
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, batchv1.ForbidConcurrent, testBuilder.Definition.Spec.ConcurrencyPolicy)
+	assert.Equal(t, int32(1), *testBuilder.Definition.Spec.SuccessfulJobsHistoryLimit)
+	assert.Equal(t, int32(2), *testBuilder.Definition.Spec.FailedJobsHistoryLimit)
+	assert.Equal(t, int64(30), *testBuilder.Definition.Spec.StartingDeadlineSeconds)
+	assert.Equal(t, int32(3), *testBuilder.Definition.Spec.JobTemplate.Spec.BackoffLimit)
+
+	testBuilder = buildValidCronJobTestBuilder(nil).WithConcurrencyPolicy("Sometimes")
+	assert.Equal(t, "cronjob 'concurrencyPolicy' must be one of Allow, Forbid or Replace", testBuilder.errorMsg)
+
+	testBuilder = buildValidCronJobTestBuilder(nil).WithJobOptions(func(builder *Builder) (*Builder, error) {
+		return builder.WithBackoffLimit(-1), nil
+	})
+	assert.Equal(t, "job 'backoffLimit' cannot be negative", testBuilder.errorMsg)
+}
+
+func TestCronJobSuspendResume(t *testing.T) {
+	testBuilder := buildValidCronJobTestBuilder([]runtime.Object{buildTestCronJob()})
+
+	testBuilder, err := testBuilder.Suspend()
+	assert.Nil(t, err)
+	assert.True(t, *testBuilder.Object.Spec.Suspend)
+
+	testBuilder, err = testBuilder.Resume()
+	assert.Nil(t, err)
+	assert.False(t, *testBuilder.Object.Spec.Suspend)
+
+	testBuilder = testBuilder.WithConcurrencyPolicy(batchv1.ReplaceConcurrent)
+
+	testBuilder, err = testBuilder.Suspend()
+	assert.Nil(t, err)
+	assert.True(t, *testBuilder.Object.Spec.Suspend)
+	assert.True(t, *testBuilder.Definition.Spec.Suspend)
+	assert.NotEqual(t, batchv1.ReplaceConcurrent, testBuilder.Object.Spec.ConcurrencyPolicy)
+	assert.Equal(t, batchv1.ReplaceConcurrent, testBuilder.Definition.Spec.ConcurrencyPolicy)
+	assert.NotSame(t, testBuilder.Definition, testBuilder.Object)
+
+	_, err = buildValidCronJobTestBuilder(nil).Suspend()
+	assert.Equal(t, fmt.Errorf("cronjob object test-cronjob does not exist in namespace test-ns"), err)
+}
+
+func TestCronJobTrigger(t *testing.T) {
+	testBuilder := buildValidCronJobTestBuilder([]runtime.Object{buildTestCronJob()})
+
+	jobBuilder, err := testBuilder.Trigger("test-cronjob-manual")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-cronjob-manual", jobBuilder.Object.Name)
+	assert.Equal(t, "manual", jobBuilder.Object.Annotations[cronJobInstantiateAnnotation])
+	assert.Equal(t, "CronJob", jobBuilder.Object.OwnerReferences[0].Kind)
+	assert.Equal(t, defaultCronJobName, jobBuilder.Object.OwnerReferences[0].Name)
+	assert.Equal(t, "test-image", jobBuilder.Object.Spec.Template.Spec.Containers[0].Image)
+
+	_, err = testBuilder.Trigger("")
+	assert.Equal(t, fmt.Errorf("job 'name' cannot be empty"), err)
+
+	_, err = buildValidCronJobTestBuilder(nil).Trigger("test-cronjob-manual")
+	assert.Equal(t,
+		fmt.Errorf("cannot trigger job from cronjob test-cronjob which does not exist in namespace test-ns"), err)
+}
+
+func buildValidCronJobTestBuilder(objects []runtime.Object) *CronJobBuilder {
+	return NewCronJobBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects}),
+		defaultCronJobName, defaultJobNamespace, defaultCronJobSchedule, buildTestContainer())
+}
+
+func buildTestCronJob() *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultCronJobName,
+			Namespace: defaultJobNamespace,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: defaultCronJobSchedule,
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{*buildTestContainer()}},
+					},
+				},
+			},
+		},
+	}
+}
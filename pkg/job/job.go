@@ -0,0 +1,771 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Builder provides struct for job object containing connection to the cluster and the job definitions.
+type Builder struct {
+	// Job definition. Used to create the job object.
+	Definition *batchv1.Job
+	// Created job object.
+	Object *batchv1.Job
+	// Used in functions that define or mutate job definition. errorMsg is processed before the job
+	// object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for job object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name, nsname string, containerSpec *corev1.Container) *Builder {
+	glog.V(100).Infof(
+		"Initializing new job structure with the following params: "+
+			"name: %s, namespace: %s, containerSpec %v",
+		name, nsname, containerSpec)
+
+	if apiClient == nil {
+		glog.V(100).Infof("job 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+					},
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the job is empty")
+
+		builder.errorMsg = "job 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the job is empty")
+
+		builder.errorMsg = "job 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if containerSpec == nil {
+		glog.V(100).Infof("The containerSpec of the job is empty")
+
+		builder.errorMsg = "job 'containerSpec' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Containers = []corev1.Container{*containerSpec}
+
+	return builder
+}
+
+// Pull loads an existing job into the Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing job name: %s under namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("job 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the job is empty")
+
+		return nil, fmt.Errorf("job 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the job is empty")
+
+		return nil, fmt.Errorf("job 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("job object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithAdditionalContainerSpecs appends a list of container specs to the job definition.
+func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Appending a list of container specs %v to job %s in namespace %s",
+		specs, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(specs) == 0 {
+		glog.V(100).Infof("The container specs are empty")
+
+		builder.errorMsg = "cannot accept empty list as container specs"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Containers = append(builder.Definition.Spec.Template.Spec.Containers, specs...)
+
+	return builder
+}
+
+// WithParallelism sets the maximum number of pods the job runs in parallel.
+func (builder *Builder) WithParallelism(parallelism int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting parallelism %d in job %s in namespace %s",
+		parallelism, builder.Definition.Name, builder.Definition.Namespace)
+
+	if parallelism < 0 {
+		glog.V(100).Infof("The parallelism of the job cannot be negative")
+
+		builder.errorMsg = "job 'parallelism' cannot be negative"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Parallelism = &parallelism
+
+	return builder
+}
+
+// WithCompletions sets the desired number of successfully finished pods of the job.
+func (builder *Builder) WithCompletions(completions int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting completions %d in job %s in namespace %s",
+		completions, builder.Definition.Name, builder.Definition.Namespace)
+
+	if completions < 0 {
+		glog.V(100).Infof("The completions of the job cannot be negative")
+
+		builder.errorMsg = "job 'completions' cannot be negative"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Completions = &completions
+
+	return builder
+}
+
+// WithBackoffLimit sets the number of retries before marking the job as failed.
+func (builder *Builder) WithBackoffLimit(backoffLimit int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting backoffLimit %d in job %s in namespace %s",
+		backoffLimit, builder.Definition.Name, builder.Definition.Namespace)
+
+	if backoffLimit < 0 {
+		glog.V(100).Infof("The backoffLimit of the job cannot be negative")
+
+		builder.errorMsg = "job 'backoffLimit' cannot be negative"
+
+		return builder
+	}
+
+	builder.Definition.Spec.BackoffLimit = &backoffLimit
+
+	return builder
+}
+
+// WithTTLSecondsAfterFinished sets the time after which a finished job is eligible to be automatically deleted.
+func (builder *Builder) WithTTLSecondsAfterFinished(ttlSeconds int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting ttlSecondsAfterFinished %d in job %s in namespace %s",
+		ttlSeconds, builder.Definition.Name, builder.Definition.Namespace)
+
+	if ttlSeconds < 0 {
+		glog.V(100).Infof("The ttlSecondsAfterFinished of the job cannot be negative")
+
+		builder.errorMsg = "job 'ttlSecondsAfterFinished' cannot be negative"
+
+		return builder
+	}
+
+	builder.Definition.Spec.TTLSecondsAfterFinished = &ttlSeconds
+
+	return builder
+}
+
+// WithActiveDeadlineSeconds sets the duration after which the job is terminated regardless of its progress.
+func (builder *Builder) WithActiveDeadlineSeconds(deadlineSeconds int64) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting activeDeadlineSeconds %d in job %s in namespace %s",
+		deadlineSeconds, builder.Definition.Name, builder.Definition.Namespace)
+
+	if deadlineSeconds <= 0 {
+		glog.V(100).Infof("The activeDeadlineSeconds of the job must be positive")
+
+		builder.errorMsg = "job 'activeDeadlineSeconds' must be positive"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ActiveDeadlineSeconds = &deadlineSeconds
+
+	return builder
+}
+
+// WithRestartPolicy sets the restart policy of the job pods. Only Never and OnFailure are allowed.
+func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting restartPolicy %s in job %s in namespace %s",
+		restartPolicy, builder.Definition.Name, builder.Definition.Namespace)
+
+	if restartPolicy != corev1.RestartPolicyNever && restartPolicy != corev1.RestartPolicyOnFailure {
+		glog.V(100).Infof("The restartPolicy %s is not supported by jobs", restartPolicy)
+
+		builder.errorMsg = "job 'restartPolicy' must be either Never or OnFailure"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.RestartPolicy = restartPolicy
+
+	return builder
+}
+
+// WithNodeSelector applies a nodeSelector to the job definition.
+func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Applying nodeSelector %s to job %s in namespace %s",
+		selector, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(selector) == 0 {
+		glog.V(100).Infof("The nodeSelector of the job is empty")
+
+		builder.errorMsg = "job 'nodeSelector' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.NodeSelector = selector
+
+	return builder
+}
+
+// WithLabel applies label to the job pod template.
+func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Defining job's label to %s:%s", labelKey, labelValue)
+
+	if labelKey == "" {
+		glog.V(100).Infof("The 'labelKey' of the job is empty")
+
+		builder.errorMsg = "can not apply empty labelKey"
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Template.Labels == nil {
+		builder.Definition.Spec.Template.Labels = map[string]string{}
+	}
+
+	builder.Definition.Spec.Template.Labels[labelKey] = labelValue
+
+	return builder
+}
+
+// WithServiceAccountName sets the ServiceAccountName on job definition.
+func (builder *Builder) WithServiceAccountName(serviceAccountName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting ServiceAccount %s on job %s in namespace %s",
+		serviceAccountName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if serviceAccountName == "" {
+		glog.V(100).Infof("The 'serviceAccount' of the job is empty")
+
+		builder.errorMsg = "can not apply empty serviceAccount"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.ServiceAccountName = serviceAccountName
+
+	return builder
+}
+
+// WithVolume attaches given volume to the job.
+func (builder *Builder) WithVolume(volume corev1.Volume) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if volume.Name == "" {
+		glog.V(100).Infof("The volume's name cannot be empty")
+
+		builder.errorMsg = "the volume's name cannot be empty"
+
+		return builder
+	}
+
+	glog.V(100).Infof("Adding volume %s to job %s in namespace %s",
+		volume.Name, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Template.Spec.Volumes = append(builder.Definition.Spec.Template.Spec.Volumes, volume)
+
+	return builder
+}
+
+// WithToleration applies a toleration to the job's definition.
+func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if toleration == (corev1.Toleration{}) {
+		glog.V(100).Infof("The toleration cannot be empty")
+
+		builder.errorMsg = "the toleration cannot be empty"
+
+		return builder
+	}
+
+	glog.V(100).Infof("Adding TaintToleration %v to job %s in namespace %s",
+		toleration, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Template.Spec.Tolerations = append(
+		builder.Definition.Spec.Template.Spec.Tolerations, toleration)
+
+	return builder
+}
+
+// WithOptions creates job with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting job additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a job in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating job %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing job object with the job definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating job %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the job together with its pods.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting job %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+
+	err := builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// DeleteAndWait deletes a job and waits until it is removed from the cluster.
+func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting job %s in namespace %s and waiting for the defined period until it is removed",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.Delete(); err != nil {
+		return err
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			return k8serrors.IsNotFound(err), nil
+		})
+}
+
+// Exists checks whether the given job exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if job %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// CreateAndWaitUntilComplete creates a job in the cluster and waits until it completes.
+func (builder *Builder) CreateAndWaitUntilComplete(timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating job %s in namespace %s and waiting for the defined period until it is complete",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if _, err := builder.Create(); err != nil {
+		glog.V(100).Infof("Failed to create job. Error is: '%s'", err.Error())
+
+		return builder, err
+	}
+
+	return builder, builder.WaitUntilComplete(timeout)
+}
+
+// WaitUntilComplete waits for the duration of the defined timeout or until the job completes. If the job fails
+// instead, the returned error contains the reasons reported by its failed pods.
+func (builder *Builder) WaitUntilComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until job %s in namespace %s is complete",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var failedCondition *batchv1.JobCondition
+
+	err := builder.waitForFinishedCondition(timeout, func(condition batchv1.JobCondition) (bool, error) {
+		if condition.Type == batchv1.JobFailed {
+			failedCondition = &condition
+
+			return false, fmt.Errorf("job %s failed", builder.Definition.Name)
+		}
+
+		return condition.Type == batchv1.JobComplete, nil
+	})
+
+	if failedCondition != nil {
+		return builder.failureError(failedCondition)
+	}
+
+	return err
+}
+
+// WaitUntilFailed waits for the duration of the defined timeout or until the job fails. An error is returned if the
+// job completes successfully instead.
+func (builder *Builder) WaitUntilFailed(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until job %s in namespace %s is failed",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.waitForFinishedCondition(timeout, func(condition batchv1.JobCondition) (bool, error) {
+		if condition.Type == batchv1.JobComplete {
+			return false, fmt.Errorf("job %s completed successfully", builder.Definition.Name)
+		}
+
+		return condition.Type == batchv1.JobFailed, nil
+	})
+}
+
+// GetPods returns the pods owned by the job.
+func (builder *Builder) GetPods() ([]*pod.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Listing pods of job %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("job %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector of job %s: %w", builder.Definition.Name, err)
+	}
+
+	return pod.List(builder.apiClient, builder.Definition.Namespace, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+}
+
+// GetFailedPodReasons returns a description of every failed pod of the job keyed by pod name, including the
+// termination reason, exit code and message of its failed containers.
+func (builder *Builder) GetFailedPodReasons() (map[string]string, error) {
+	pods, err := builder.GetPods()
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := make(map[string]string)
+
+	for _, jobPod := range pods {
+		if jobPod.Object.Status.Phase != corev1.PodFailed {
+			continue
+		}
+
+		var podReasons []string
+
+		if jobPod.Object.Status.Reason != "" {
+			podReasons = append(podReasons, jobPod.Object.Status.Reason)
+		}
+
+		for _, status := range jobPod.Object.Status.ContainerStatuses {
+			if status.State.Terminated == nil || status.State.Terminated.ExitCode == 0 {
+				continue
+			}
+
+			podReasons = append(podReasons, fmt.Sprintf("container %s terminated with reason %s, exit code %d: %s",
+				status.Name, status.State.Terminated.Reason, status.State.Terminated.ExitCode,
+				status.State.Terminated.Message))
+		}
+
+		reasons[jobPod.Object.Name] = strings.Join(podReasons, ", ")
+	}
+
+	return reasons, nil
+}
+
+// GetLogs collects the full logs of every container of every job pod. The result is keyed by pod name and then
+// by container name.
+func (builder *Builder) GetLogs() (map[string]map[string]string, error) {
+	pods, err := builder.GetPods()
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make(map[string]map[string]string)
+
+	for _, jobPod := range pods {
+		logs[jobPod.Object.Name] = make(map[string]string)
+
+		for _, container := range jobPod.Object.Spec.Containers {
+			log, err := jobPod.GetFullLog(container.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get log of container %s in pod %s: %w",
+					container.Name, jobPod.Object.Name, err)
+			}
+
+			logs[jobPod.Object.Name][container.Name] = log
+		}
+	}
+
+	return logs, nil
+}
+
+// GetGVR returns job's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+}
+
+// waitForFinishedCondition polls the job until checkCondition returns true for one of its true conditions.
+func (builder *Builder) waitForFinishedCondition(
+	timeout time.Duration, checkCondition func(condition batchv1.JobCondition) (bool, error)) error {
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				glog.V(100).Infof("Failed to get job %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			for _, condition := range builder.Object.Status.Conditions {
+				if condition.Status != corev1.ConditionTrue {
+					continue
+				}
+
+				done, err := checkCondition(condition)
+				if done || err != nil {
+					return done, err
+				}
+			}
+
+			return false, nil
+		})
+}
+
+func (builder *Builder) failureError(condition *batchv1.JobCondition) error {
+	failureMessage := fmt.Sprintf("job %s in namespace %s failed with reason %s: %s",
+		builder.Definition.Name, builder.Definition.Namespace, condition.Reason, condition.Message)
+
+	reasons, err := builder.GetFailedPodReasons()
+	if err != nil {
+		glog.V(100).Infof("Failed to collect failed pod reasons of job %s: %v", builder.Definition.Name, err)
+
+		return fmt.Errorf("%s", failureMessage)
+	}
+
+	podNames := make([]string, 0, len(reasons))
+	for podName := range reasons {
+		podNames = append(podNames, podName)
+	}
+
+	sort.Strings(podNames)
+
+	for _, podName := range podNames {
+		failureMessage += fmt.Sprintf("; pod %s: %s", podName, reasons[podName])
+	}
+
+	return fmt.Errorf("%s", failureMessage)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "Job"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,321 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultJobName      = "test-job"
+	defaultJobNamespace = "test-ns"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		containerSpec *corev1.Container
+		expectedError string
+	}{
+		{
+			name:          defaultJobName,
+			namespace:     defaultJobNamespace,
+			containerSpec: buildTestContainer(),
+		},
+		{
+			name:          "",
+			namespace:     defaultJobNamespace,
+			containerSpec: buildTestContainer(),
+			expectedError: "job 'name' cannot be empty",
+		},
+		{
+			name:          defaultJobName,
+			namespace:     "",
+			containerSpec: buildTestContainer(),
+			expectedError: "job 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultJobName,
+			namespace:     defaultJobNamespace,
+			containerSpec: nil,
+			expectedError: "job 'containerSpec' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.namespace, testCase.containerSpec)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, corev1.RestartPolicyNever, testBuilder.Definition.Spec.Template.Spec.RestartPolicy)
+			assert.Len(t, testBuilder.Definition.Spec.Template.Spec.Containers, 1)
+		}
+	}
+
+	assert.Nil(t, NewBuilder(nil, defaultJobName, defaultJobNamespace, buildTestContainer()))
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultJobName,
+			namespace:           defaultJobNamespace,
+			addToRuntimeObjects: true,
+		},
+		{
+			name:                defaultJobName,
+			namespace:           defaultJobNamespace,
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("job object test-job does not exist in namespace test-ns"),
+		},
+		{
+			name:          "",
+			namespace:     defaultJobNamespace,
+			expectedError: fmt.Errorf("job 'name' cannot be empty"),
+		},
+		{
+			name:          defaultJobName,
+			namespace:     "",
+			expectedError: fmt.Errorf("job 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildTestJob(nil))
+		}
+
+		testBuilder, err := Pull(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}),
+			testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestJobWithSettings(t *testing.T) {
+	testBuilder := buildValidJobTestBuilder(nil).
+		WithParallelism(2).
+		WithCompletions(4).
+		WithBackoffLimit(1).
+		WithTTLSecondsAfterFinished(60).
+		WithActiveDeadlineSeconds(600).
+		WithRestartPolicy(corev1.RestartPolicyOnFailure)
+
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, int32(2), *testBuilder.Definition.Spec.Parallelism)
+	assert.Equal(t, int32(4), *testBuilder.Definition.Spec.Completions)
+	assert.Equal(t, int32(1), *testBuilder.Definition.Spec.BackoffLimit)
+	assert.Equal(t, int32(60), *testBuilder.Definition.Spec.TTLSecondsAfterFinished)
+	assert.Equal(t, int64(600), *testBuilder.Definition.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, corev1.RestartPolicyOnFailure, testBuilder.Definition.Spec.Template.Spec.RestartPolicy)
+
+	testCases := []struct {
+		mutate        func(builder *Builder) *Builder
+		expectedError string
+	}{
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithParallelism(-1) },
+			expectedError: "job 'parallelism' cannot be negative",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithCompletions(-1) },
+			expectedError: "job 'completions' cannot be negative",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithBackoffLimit(-1) },
+			expectedError: "job 'backoffLimit' cannot be negative",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithTTLSecondsAfterFinished(-1) },
+			expectedError: "job 'ttlSecondsAfterFinished' cannot be negative",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithActiveDeadlineSeconds(0) },
+			expectedError: "job 'activeDeadlineSeconds' must be positive",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithRestartPolicy(corev1.RestartPolicyAlways) },
+			expectedError: "job 'restartPolicy' must be either Never or OnFailure",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithNodeSelector(nil) },
+			expectedError: "job 'nodeSelector' cannot be empty",
+		},
+		{
+			mutate:        func(builder *Builder) *Builder { return builder.WithAdditionalContainerSpecs(nil) },
+			expectedError: "cannot accept empty list as container specs",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.mutate(buildValidJobTestBuilder(nil))
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+	}
+}
+
+func TestJobCreate(t *testing.T) {
+	testBuilder, err := buildValidJobTestBuilder(nil).Create()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultJobName, testBuilder.Object.Name)
+	assert.True(t, testBuilder.Exists())
+}
+
+func TestJobDelete(t *testing.T) {
+	testBuilder := buildValidJobTestBuilder([]runtime.Object{buildTestJob(nil)})
+
+	err := testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+
+	err = testBuilder.DeleteAndWait(time.Second)
+	assert.Nil(t, err)
+}
+
+func TestJobWaitUntilComplete(t *testing.T) {
+	testCases := []struct {
+		conditions    []batchv1.JobCondition
+		pods          []runtime.Object
+		expectedError error
+	}{
+		{
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+		},
+		{
+			conditions: []batchv1.JobCondition{{
+				Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit"}},
+			pods: []runtime.Object{buildTestJobPod("test-job-abcde", corev1.PodFailed)},
+			expectedError: fmt.Errorf("job test-job in namespace test-ns failed with reason BackoffLimitExceeded: " +
+				"Job has reached the specified backoff limit; pod test-job-abcde: container test terminated with " +
+				"reason Error, exit code 1: boom"),
+		},
+		{
+			conditions:    nil,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := append([]runtime.Object{buildTestJob(testCase.conditions)}, testCase.pods...)
+		testBuilder := buildValidJobTestBuilder(runtimeObjects)
+
+		err := testBuilder.WaitUntilComplete(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestJobWaitUntilFailed(t *testing.T) {
+	testCases := []struct {
+		conditions    []batchv1.JobCondition
+		expectedError error
+	}{
+		{
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+		},
+		{
+			conditions:    []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			expectedError: fmt.Errorf("job test-job completed successfully"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidJobTestBuilder([]runtime.Object{buildTestJob(testCase.conditions)})
+
+		err := testBuilder.WaitUntilFailed(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestJobGetLogs(t *testing.T) {
+	testBuilder := buildValidJobTestBuilder([]runtime.Object{
+		buildTestJob(nil),
+		buildTestJobPod("test-job-abcde", corev1.PodSucceeded),
+		buildTestJobPod("test-job-fghij", corev1.PodSucceeded),
+	})
+
+	logs, err := testBuilder.GetLogs()
+	assert.Nil(t, err)
+	assert.Len(t, logs, 2)
+	assert.Equal(t, "fake logs", logs["test-job-abcde"]["test"])
+}
+
+func TestJobValidate(t *testing.T) {
+	var nilBuilder *Builder
+
+	_, err := nilBuilder.Create()
+	assert.Equal(t, fmt.Errorf("error: received nil Job builder"), err)
+
+	testBuilder := buildValidJobTestBuilder(nil)
+	testBuilder.apiClient = nil
+
+	_, err = testBuilder.Create()
+	assert.Equal(t, fmt.Errorf("Job builder cannot have nil apiClient"), err)
+}
+
+func buildValidJobTestBuilder(objects []runtime.Object) *Builder {
+	return NewBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects}),
+		defaultJobName, defaultJobNamespace, buildTestContainer())
+}
+
+func buildTestContainer() *corev1.Container {
+	return &corev1.Container{Name: "test", Image: "test-image"}
+}
+
+func buildTestJob(conditions []batchv1.JobCondition) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultJobName,
+			Namespace: defaultJobNamespace,
+		},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": defaultJobName}},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{*buildTestContainer()}},
+			},
+		},
+		Status: batchv1.JobStatus{Conditions: conditions},
+	}
+}
+
+func buildTestJobPod(name string, phase corev1.PodPhase) *corev1.Pod {
+	jobPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultJobNamespace,
+			Labels:    map[string]string{"job-name": defaultJobName},
+		},
+		Spec:   corev1.PodSpec{Containers: []corev1.Container{*buildTestContainer()}},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+
+	if phase == corev1.PodFailed {
+		jobPod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name: "test",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				ExitCode: 1, Reason: "Error", Message: "boom"}},
+		}}
+	}
+
+	return jobPod
+}
@@ -0,0 +1,306 @@
+package limitrange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Builder provides struct for limitrange object containing connection to the cluster and the limitrange
+// definitions.
+type Builder struct {
+	// LimitRange definition. Used to create a limitrange object.
+	Definition *corev1.LimitRange
+	// Created limitrange object.
+	Object *corev1.LimitRange
+	// Used in functions that define or mutate limitrange definition. errorMsg is processed before the limitrange
+	// object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for limitrange object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder. Limits are added with WithLimit or WithContainerDefaults.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	glog.V(100).Infof(
+		"Initializing new limitrange structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("limitrange 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the limitrange is empty")
+
+		builder.errorMsg = "limitrange 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the limitrange is empty")
+
+		builder.errorMsg = "limitrange 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// Pull loads an existing limitrange into the Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing limitrange name: %s under namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("limitrange 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("limitrange 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("limitrange 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("limitrange object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithLimit appends a limit to the limitrange. The limit type must be Pod, Container or PersistentVolumeClaim and
+// every minimum must not exceed the matching maximum.
+func (builder *Builder) WithLimit(limit corev1.LimitRangeItem) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding limit %v to limitrange %s in namespace %s",
+		limit, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateLimit(limit); err != nil {
+		builder.errorMsg = fmt.Sprintf("limitrange limit is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.Limits = append(builder.Definition.Spec.Limits, limit)
+
+	return builder
+}
+
+// WithContainerDefaults appends a Container limit setting the default requests and limits applied to containers
+// that do not define their own.
+func (builder *Builder) WithContainerDefaults(defaultRequest, defaultLimit corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if len(defaultRequest) == 0 && len(defaultLimit) == 0 {
+		builder.errorMsg = "limitrange container defaults cannot be empty"
+
+		return builder
+	}
+
+	return builder.WithLimit(corev1.LimitRangeItem{
+		Type:           corev1.LimitTypeContainer,
+		DefaultRequest: defaultRequest,
+		Default:        defaultLimit,
+	})
+}
+
+// WithOptions creates limitrange with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting limitrange additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Exists checks whether the given limitrange exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if limitrange %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.LimitRanges(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create builds limitrange in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating limitrange %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.Limits) == 0 {
+		return builder, fmt.Errorf("limitrange %s must have at least one limit", builder.Definition.Name)
+	}
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.LimitRanges(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing limitrange object with the limitrange definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating limitrange %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("limitrange object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.LimitRanges(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the limitrange.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting limitrange %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.LimitRanges(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete limitrange: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+func validateLimit(limit corev1.LimitRangeItem) error {
+	switch limit.Type {
+	case corev1.LimitTypePod, corev1.LimitTypeContainer, corev1.LimitTypePersistentVolumeClaim:
+	default:
+		return fmt.Errorf("type %s is not supported", limit.Type)
+	}
+
+	for resourceName, minQuantity := range limit.Min {
+		if maxQuantity, ok := limit.Max[resourceName]; ok && minQuantity.Cmp(maxQuantity) > 0 {
+			return fmt.Errorf("min %s of %s is greater than max %s",
+				minQuantity.String(), resourceName, maxQuantity.String())
+		}
+	}
+
+	return nil
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "LimitRange"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
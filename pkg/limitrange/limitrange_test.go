@@ -0,0 +1,464 @@
+package limitrange
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultLimitRangeName      = "test-limitrange"
+	defaultLimitRangeNamespace = "test-namespace"
+	defaultContainerLimit      = corev1.LimitRangeItem{
+		Type: corev1.LimitTypeContainer,
+		Min:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+	}
+)
+
+func TestLimitRangeNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultLimitRangeName,
+			namespace:     defaultLimitRangeNamespace,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultLimitRangeNamespace,
+			client:        true,
+			expectedError: "limitrange 'name' cannot be empty",
+		},
+		{
+			name:          defaultLimitRangeName,
+			namespace:     "",
+			client:        true,
+			expectedError: "limitrange 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultLimitRangeName,
+			namespace:     defaultLimitRangeNamespace,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestLimitRangePull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultLimitRangeName,
+			namespace:           defaultLimitRangeNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultLimitRangeName,
+			namespace:           defaultLimitRangeNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"limitrange object test-limitrange does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultLimitRangeNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("limitrange 'name' cannot be empty"),
+		},
+		{
+			name:                defaultLimitRangeName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("limitrange 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultLimitRangeName,
+			namespace:           defaultLimitRangeNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("limitrange 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyLimitRange()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, []corev1.LimitRangeItem{defaultContainerLimit}, testBuilder.Object.Spec.Limits)
+		}
+	}
+}
+
+func TestLimitRangeWithLimit(t *testing.T) {
+	testCases := []struct {
+		limit         corev1.LimitRangeItem
+		expectedError string
+	}{
+		{
+			limit:         defaultContainerLimit,
+			expectedError: "",
+		},
+		{
+			limit: corev1.LimitRangeItem{
+				Type: corev1.LimitTypePersistentVolumeClaim,
+				Max:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+			expectedError: "",
+		},
+		{
+			limit:         corev1.LimitRangeItem{Type: "Node"},
+			expectedError: "limitrange limit is invalid: type Node is not supported",
+		},
+		{
+			limit: corev1.LimitRangeItem{
+				Type: corev1.LimitTypePod,
+				Min:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+				Max:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			expectedError: "limitrange limit is invalid: min 2Gi of memory is greater than max 1Gi",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()).WithLimit(testCase.limit)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []corev1.LimitRangeItem{testCase.limit}, testBuilder.Definition.Spec.Limits)
+		}
+	}
+}
+
+func TestLimitRangeWithContainerDefaults(t *testing.T) {
+	testCases := []struct {
+		defaultRequest corev1.ResourceList
+		defaultLimit   corev1.ResourceList
+		expectedError  string
+	}{
+		{
+			defaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			defaultLimit:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			expectedError:  "",
+		},
+		{
+			defaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			defaultLimit:   nil,
+			expectedError:  "",
+		},
+		{
+			defaultRequest: nil,
+			defaultLimit:   nil,
+			expectedError:  "limitrange container defaults cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()).
+			WithContainerDefaults(testCase.defaultRequest, testCase.defaultLimit)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, corev1.LimitTypeContainer, testBuilder.Definition.Spec.Limits[0].Type)
+			assert.Equal(t, testCase.defaultRequest, testBuilder.Definition.Spec.Limits[0].DefaultRequest)
+			assert.Equal(t, testCase.defaultLimit, testBuilder.Definition.Spec.Limits[0].Default)
+		}
+	}
+}
+
+func TestLimitRangeWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    AdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				builder.Definition.Spec.Limits = []corev1.LimitRangeItem{defaultContainerLimit}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []corev1.LimitRangeItem{defaultContainerLimit}, testBuilder.Definition.Spec.Limits)
+		}
+	}
+}
+
+func TestLimitRangeExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestLimitRangeCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithLimit(defaultContainerLimit),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf("limitrange test-limitrange must have at least one limit"),
+		},
+		{
+			testBuilder:   buildInvalidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedError: fmt.Errorf("limitrange 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Spec.Limits, testBuilder.Object.Spec.Limits)
+		}
+	}
+}
+
+func TestLimitRangeUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf(
+				"limitrange object test-limitrange does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedError: fmt.Errorf("limitrange 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithContainerDefaults(
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")}, nil).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, testBuilder.Object.Spec.Limits, 1)
+			assert.Equal(t, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+				testBuilder.Object.Spec.Limits[0].DefaultRequest)
+		}
+	}
+}
+
+func TestLimitRangeDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidLimitRangeBuilder(buildLimitRangeClientWithDummyObject()),
+			expectedError: fmt.Errorf("limitrange 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestLimitRangeValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil LimitRange builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined LimitRange",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "LimitRange builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidLimitRangeBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultLimitRangeName, defaultLimitRangeNamespace)
+}
+
+func buildInvalidLimitRangeBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultLimitRangeName, "")
+}
+
+func buildLimitRangeClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyLimitRange(),
+	})
+}
+
+func buildDummyLimitRange() []runtime.Object {
+	return append([]runtime.Object{}, &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultLimitRangeName,
+			Namespace: defaultLimitRangeNamespace,
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{defaultContainerLimit},
+		},
+	})
+}
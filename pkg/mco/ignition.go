@@ -0,0 +1,394 @@
+package mco
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+
+	ign3types "github.com/coreos/ignition/v2/config/v3_2/types"
+	ign3validate "github.com/coreos/ignition/v2/config/validate"
+	"github.com/golang/glog"
+	mcocommon "github.com/openshift/machine-config-operator/pkg/controller/common"
+	"github.com/vincent-petithory/dataurl"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const maxFileMode = 07777
+
+// supportedSourceSchemes are the URL schemes ignition can fetch file contents from.
+var supportedSourceSchemes = map[string]bool{
+	"http": true, "https": true, "tftp": true, "s3": true, "gs": true, "data": true,
+}
+
+// IgnitionFile describes a file defined in the ignition config of a MachineConfig.
+type IgnitionFile struct {
+	Path      string
+	Mode      int
+	Overwrite bool
+	// Source is the URL the file contents are fetched from.
+	Source string
+	// Contents are the decoded, and decompressed if gzip compressed, contents of a data URL source. They are nil for
+	// remote sources.
+	Contents []byte
+}
+
+// WithFile adds a file with the given contents to the ignition config of the MachineConfig. The contents are encoded
+// as a data URL. A file already defined with the same path is replaced.
+func (builder *MCBuilder) WithFile(path string, contents []byte, mode int, overwrite bool) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding file %s with mode %#o to the machineconfig %s", path, mode, builder.Definition.Name)
+
+	return builder.withFileSource(path, dataurl.EncodeBytes(contents), mode, overwrite)
+}
+
+// WithFileFromSource adds a file fetched from the given URL to the ignition config of the MachineConfig. The supported
+// schemes are http, https, tftp, s3, gs and data. A file already defined with the same path is replaced.
+func (builder *MCBuilder) WithFileFromSource(path, source string, mode int, overwrite bool) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding file %s from %s with mode %#o to the machineconfig %s",
+		path, source, mode, builder.Definition.Name)
+
+	sourceURL, err := url.Parse(source)
+	if err != nil || !supportedSourceSchemes[sourceURL.Scheme] {
+		glog.V(100).Infof("The file source %s is not a supported URL", source)
+
+		builder.errorMsg = fmt.Sprintf("file source %s is not a supported URL", source)
+
+		return builder
+	}
+
+	return builder.withFileSource(path, source, mode, overwrite)
+}
+
+// WithDirectory adds a directory to the ignition config of the MachineConfig. A directory already defined with the
+// same path is replaced.
+func (builder *MCBuilder) WithDirectory(path string, mode int) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding directory %s with mode %#o to the machineconfig %s", path, mode, builder.Definition.Name)
+
+	if !isValidNodePath(path, mode) {
+		builder.errorMsg = fmt.Sprintf("directory path %s must be absolute with a mode between 0 and %#o", path, maxFileMode)
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		directory := ign3types.Directory{
+			Node:               ign3types.Node{Path: path},
+			DirectoryEmbedded1: ign3types.DirectoryEmbedded1{Mode: ptr.To(mode)},
+		}
+
+		for index := range config.Storage.Directories {
+			if config.Storage.Directories[index].Path == path {
+				config.Storage.Directories[index] = directory
+
+				return
+			}
+		}
+
+		config.Storage.Directories = append(config.Storage.Directories, directory)
+	})
+}
+
+// WithSystemdUnit adds a systemd unit to the ignition config of the MachineConfig. If the unit is already defined, its
+// contents and enablement are replaced while its dropins are kept.
+func (builder *MCBuilder) WithSystemdUnit(name, contents string, enabled bool) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding systemd unit %s with enabled %t to the machineconfig %s",
+		name, enabled, builder.Definition.Name)
+
+	if name == "" {
+		glog.V(100).Infof("The systemd unit name is empty")
+
+		builder.errorMsg = "systemd unit 'name' cannot be empty"
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		unit := getOrAddUnit(config, name)
+		unit.Contents = ptr.To(contents)
+		unit.Enabled = ptr.To(enabled)
+		unit.Mask = nil
+	})
+}
+
+// WithMaskedSystemdUnit adds a masked systemd unit to the ignition config of the MachineConfig.
+func (builder *MCBuilder) WithMaskedSystemdUnit(name string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Masking systemd unit %s in the machineconfig %s", name, builder.Definition.Name)
+
+	if name == "" {
+		glog.V(100).Infof("The systemd unit name is empty")
+
+		builder.errorMsg = "systemd unit 'name' cannot be empty"
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		unit := getOrAddUnit(config, name)
+		unit.Mask = ptr.To(true)
+		unit.Enabled = nil
+	})
+}
+
+// WithSystemdDropin adds a dropin to the given systemd unit in the ignition config of the MachineConfig. The unit does
+// not need to be defined by the MachineConfig. A dropin already defined with the same name is replaced.
+func (builder *MCBuilder) WithSystemdDropin(unitName, dropinName, contents string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding dropin %s for systemd unit %s to the machineconfig %s",
+		dropinName, unitName, builder.Definition.Name)
+
+	if unitName == "" {
+		glog.V(100).Infof("The systemd unit name is empty")
+
+		builder.errorMsg = "systemd unit 'name' cannot be empty"
+
+		return builder
+	}
+
+	if dropinName == "" {
+		glog.V(100).Infof("The systemd dropin name is empty")
+
+		builder.errorMsg = "systemd dropin 'name' cannot be empty"
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		unit := getOrAddUnit(config, unitName)
+		dropin := ign3types.Dropin{Name: dropinName, Contents: ptr.To(contents)}
+
+		for index := range unit.Dropins {
+			if unit.Dropins[index].Name == dropinName {
+				unit.Dropins[index] = dropin
+
+				return
+			}
+		}
+
+		unit.Dropins = append(unit.Dropins, dropin)
+	})
+}
+
+// GetIgnitionConfig returns the ignition config of the MachineConfig definition, converted to spec version 3.2 which
+// is used by the machine-config-operator. An empty config is returned if the MachineConfig has no ignition config.
+func (builder *MCBuilder) GetIgnitionConfig() (*ign3types.Config, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Decoding ignition config of the machineconfig %s", builder.Definition.Name)
+
+	config, err := decodeIgnitionConfig(builder.Definition.Spec.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ignition config of machineconfig %s: %w", builder.Definition.Name, err)
+	}
+
+	return &config, nil
+}
+
+// GetFiles returns the files defined in the ignition config of the MachineConfig.
+func (builder *MCBuilder) GetFiles() ([]IgnitionFile, error) {
+	config, err := builder.GetIgnitionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return getIgnitionFiles(config)
+}
+
+// GetSystemdUnits returns the systemd units defined in the ignition config of the MachineConfig.
+func (builder *MCBuilder) GetSystemdUnits() ([]ign3types.Unit, error) {
+	config, err := builder.GetIgnitionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return config.Systemd.Units, nil
+}
+
+func (builder *MCBuilder) withFileSource(path, source string, mode int, overwrite bool) *MCBuilder {
+	if !isValidNodePath(path, mode) {
+		builder.errorMsg = fmt.Sprintf("file path %s must be absolute with a mode between 0 and %#o", path, maxFileMode)
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		file := ign3types.File{
+			Node: ign3types.Node{Path: path, Overwrite: ptr.To(overwrite)},
+			FileEmbedded1: ign3types.FileEmbedded1{
+				Contents: ign3types.Resource{Source: ptr.To(source)},
+				Mode:     ptr.To(mode),
+			},
+		}
+
+		for index := range config.Storage.Files {
+			if config.Storage.Files[index].Path == path {
+				config.Storage.Files[index] = file
+
+				return
+			}
+		}
+
+		config.Storage.Files = append(config.Storage.Files, file)
+	})
+}
+
+// updateIgnitionConfig decodes the ignition config of the definition, applies the mutation, validates the result and
+// stores it back as spec version 3.2. Failures are recorded in the builder errorMsg.
+func (builder *MCBuilder) updateIgnitionConfig(mutate func(config *ign3types.Config)) *MCBuilder {
+	config, err := decodeIgnitionConfig(builder.Definition.Spec.Config)
+	if err != nil {
+		glog.V(100).Infof("Failed to decode ignition config of machineconfig %s: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = fmt.Sprintf("failed to decode ignition config: %v", err)
+
+		return builder
+	}
+
+	mutate(&config)
+
+	config.Ignition.Version = ign3types.MaxVersion.String()
+
+	if report := ign3validate.ValidateWithContext(config, nil); report.IsFatal() {
+		glog.V(100).Infof("The ignition config of machineconfig %s is invalid: %s", builder.Definition.Name, report.String())
+
+		builder.errorMsg = fmt.Sprintf("invalid ignition config: %s", report.String())
+
+		return builder
+	}
+
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		glog.V(100).Infof("Failed to encode ignition config of machineconfig %s: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = fmt.Sprintf("failed to encode ignition config: %v", err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Config = runtime.RawExtension{Raw: rawConfig}
+
+	return builder
+}
+
+// decodeIgnitionConfig parses a raw ignition config of any version supported by the machine-config-operator and
+// converts it to spec version 3.2. An empty raw config results in an empty 3.2 config.
+func decodeIgnitionConfig(rawConfig runtime.RawExtension) (ign3types.Config, error) {
+	if len(rawConfig.Raw) == 0 {
+		return mcocommon.NewIgnConfig(), nil
+	}
+
+	return mcocommon.ParseAndConvertConfig(rawConfig.Raw)
+}
+
+func newIgnitionFile(file ign3types.File) (IgnitionFile, error) {
+	ignitionFile := IgnitionFile{
+		Path:      file.Path,
+		Mode:      ptr.Deref(file.Mode, 0),
+		Overwrite: ptr.Deref(file.Overwrite, false),
+		Source:    ptr.Deref(file.Contents.Source, ""),
+	}
+
+	sourceURL, err := url.Parse(ignitionFile.Source)
+	if err != nil || sourceURL.Scheme != "data" {
+		return ignitionFile, nil
+	}
+
+	decodedSource, err := dataurl.DecodeString(ignitionFile.Source)
+	if err != nil {
+		return ignitionFile, fmt.Errorf("failed to decode contents of file %s: %w", file.Path, err)
+	}
+
+	contents := decodedSource.Data
+
+	switch compression := ptr.Deref(file.Contents.Compression, ""); compression {
+	case "":
+	case "gzip":
+		contents, err = decompressGzip(contents)
+		if err != nil {
+			return ignitionFile, fmt.Errorf("failed to decompress contents of file %s: %w", file.Path, err)
+		}
+	default:
+		return ignitionFile, fmt.Errorf("file %s has unsupported compression %s", file.Path, compression)
+	}
+
+	// Empty contents are still decoded contents, unlike the nil contents of remote sources.
+	if contents == nil {
+		contents = []byte{}
+	}
+
+	ignitionFile.Contents = contents
+
+	return ignitionFile, nil
+}
+
+// getIgnitionFiles returns the files of the ignition config with their decoded contents.
+func getIgnitionFiles(config *ign3types.Config) ([]IgnitionFile, error) {
+	var files []IgnitionFile
+
+	for _, file := range config.Storage.Files {
+		ignitionFile, err := newIgnitionFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, ignitionFile)
+	}
+
+	return files, nil
+}
+
+func decompressGzip(compressed []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// getOrAddUnit returns a pointer to the unit with the given name, appending it to the config if not defined.
+func getOrAddUnit(config *ign3types.Config, name string) *ign3types.Unit {
+	for index := range config.Systemd.Units {
+		if config.Systemd.Units[index].Name == name {
+			return &config.Systemd.Units[index]
+		}
+	}
+
+	config.Systemd.Units = append(config.Systemd.Units, ign3types.Unit{Name: name})
+
+	return &config.Systemd.Units[len(config.Systemd.Units)-1]
+}
+
+func isValidNodePath(path string, mode int) bool {
+	return filepath.IsAbs(path) && filepath.Clean(path) == path && mode >= 0 && mode <= maxFileMode
+}
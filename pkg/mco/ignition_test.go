@@ -0,0 +1,280 @@
+package mco
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestMachineConfigWithFile(t *testing.T) {
+	testCases := []struct {
+		path          string
+		mode          int
+		expectedError string
+	}{
+		{
+			path:          "/etc/chrony.conf",
+			mode:          0644,
+			expectedError: "",
+		},
+		{
+			path:          "etc/chrony.conf",
+			mode:          0644,
+			expectedError: "file path etc/chrony.conf must be absolute with a mode between 0 and 07777",
+		},
+		{
+			path:          "/etc/chrony.conf",
+			mode:          010000,
+			expectedError: "file path /etc/chrony.conf must be absolute with a mode between 0 and 07777",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig())
+		testBuilder = testBuilder.WithFile(testCase.path, []byte("server ntp.example.com iburst\n"), testCase.mode, true)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			files, err := testBuilder.GetFiles()
+			assert.Nil(t, err)
+			assert.Equal(t, []IgnitionFile{{
+				Path:      testCase.path,
+				Mode:      testCase.mode,
+				Overwrite: true,
+				Source:    "data:text/plain;charset=utf-8;base64,c2VydmVyIG50cC5leGFtcGxlLmNvbSBpYnVyc3QK",
+				Contents:  []byte("server ntp.example.com iburst\n"),
+			}}, files)
+		}
+	}
+}
+
+func TestMachineConfigWithFileFromSource(t *testing.T) {
+	testBuilder := buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+		WithFileFromSource("/etc/motd", "https://example.com/motd", 0644, false).
+		WithFile("/etc/issue", []byte("old"), 0644, false).
+		WithFile("/etc/issue", []byte("new"), 0600, false)
+	assert.Empty(t, testBuilder.errorMsg)
+
+	files, err := testBuilder.GetFiles()
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "https://example.com/motd", files[0].Source)
+	assert.Nil(t, files[0].Contents)
+	assert.Equal(t, []byte("new"), files[1].Contents)
+	assert.Equal(t, 0600, files[1].Mode)
+
+	testBuilder = buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+		WithFileFromSource("/etc/motd", "ftp://example.com/motd", 0644, false)
+	assert.Equal(t, "file source ftp://example.com/motd is not a supported URL", testBuilder.errorMsg)
+}
+
+func TestMachineConfigWithSystemdUnits(t *testing.T) {
+	testBuilder := buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+		WithSystemdDropin("kubelet.service", "10-debug.conf", "[Service]\nEnvironment=KUBELET_LOG_LEVEL=4\n").
+		WithSystemdUnit("hello.service", "[Unit]\nDescription=hello\n", true).
+		WithSystemdUnit("hello.service", "[Unit]\nDescription=hello world\n", false).
+		WithMaskedSystemdUnit("rpcbind.service").
+		WithDirectory("/var/lib/hello", 0755)
+	assert.Empty(t, testBuilder.errorMsg)
+
+	units, err := testBuilder.GetSystemdUnits()
+	assert.Nil(t, err)
+	assert.Len(t, units, 3)
+
+	assert.Equal(t, "kubelet.service", units[0].Name)
+	assert.Nil(t, units[0].Contents)
+	assert.Equal(t, "10-debug.conf", units[0].Dropins[0].Name)
+
+	assert.Equal(t, "[Unit]\nDescription=hello world\n", *units[1].Contents)
+	assert.False(t, *units[1].Enabled)
+
+	assert.True(t, *units[2].Mask)
+
+	config, err := testBuilder.GetIgnitionConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "3.2.0", config.Ignition.Version)
+	assert.Equal(t, "/var/lib/hello", config.Storage.Directories[0].Path)
+	assert.Equal(t, ptr.To(0755), config.Storage.Directories[0].Mode)
+
+	testBuilder = buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+		WithSystemdUnit("hello", "[Unit]\n", true)
+	assert.Contains(t, testBuilder.errorMsg, "invalid ignition config")
+
+	testBuilder = buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+		WithSystemdDropin("kubelet.service", "", "")
+	assert.Equal(t, "systemd dropin 'name' cannot be empty", testBuilder.errorMsg)
+}
+
+func TestMachineConfigGetIgnitionConfig(t *testing.T) {
+	testBuilder := buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig())
+
+	testBuilder.Definition.Spec.Config = runtime.RawExtension{Raw: []byte(
+		`{"ignition":{"version":"3.1.0"},"systemd":{"units":[{"name":"hello.service","enabled":true}]}}`)}
+	config, err := testBuilder.GetIgnitionConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "3.2.0", config.Ignition.Version)
+	assert.Equal(t, "hello.service", config.Systemd.Units[0].Name)
+
+	testBuilder = testBuilder.WithFile("/etc/hello", []byte("hello"), 0644, true)
+	assert.Empty(t, testBuilder.errorMsg)
+
+	units, err := testBuilder.GetSystemdUnits()
+	assert.Nil(t, err)
+	assert.Len(t, units, 1)
+
+	testBuilder.Definition.Spec.Config = runtime.RawExtension{Raw: []byte(`{"ignition":{"version":"9.0.0"}}`)}
+	_, err = testBuilder.GetIgnitionConfig()
+	assert.NotNil(t, err)
+
+	testBuilder = testBuilder.WithFile("/etc/hello", []byte("hello"), 0644, true)
+	assert.Contains(t, testBuilder.errorMsg, "failed to decode ignition config")
+
+	_, err = buildValidMachineConfigTestBuilder(nil).GetIgnitionConfig()
+	assert.Equal(t, fmt.Errorf("MachineConfig builder cannot have nil apiClient"), err)
+}
+
+func TestMachineConfigGetFilesGzip(t *testing.T) {
+	testCases := []struct {
+		compression   string
+		expectedFiles []IgnitionFile
+		expectedError string
+	}{
+		{
+			compression: "gzip",
+			expectedFiles: []IgnitionFile{{
+				Path:      "/etc/hello",
+				Mode:      0644,
+				Overwrite: true,
+				Source:    buildDummyGzipDataURL(t, "hello"),
+				Contents:  []byte("hello"),
+			}},
+		},
+		{
+			compression:   "bzip2",
+			expectedError: "invalid compression method",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig())
+		testBuilder.Definition.Spec.Config = runtime.RawExtension{
+			Raw: buildDummyCompressedIgnitionConfig(t, "hello", testCase.compression),
+		}
+
+		files, err := testBuilder.GetFiles()
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedFiles, files)
+	}
+}
+
+func TestMachineConfigDiffGzip(t *testing.T) {
+	apiClient := buildTestClientWithDummyMachineConfig()
+
+	testCases := []struct {
+		contents      string
+		mode          int
+		expectedEmpty bool
+	}{
+		{
+			contents:      "hello",
+			mode:          0644,
+			expectedEmpty: true,
+		},
+		{
+			contents:      "goodbye",
+			mode:          0644,
+			expectedEmpty: false,
+		},
+		{
+			contents:      "hello",
+			mode:          0600,
+			expectedEmpty: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		oldBuilder := NewMCBuilder(apiClient, "rendered-worker-old")
+		oldBuilder.Definition.Spec.Config = runtime.RawExtension{
+			Raw: buildDummyCompressedIgnitionConfig(t, "hello", "gzip"),
+		}
+
+		newBuilder := NewMCBuilder(apiClient, "rendered-worker-new").
+			WithFile("/etc/hello", []byte(testCase.contents), testCase.mode, true)
+
+		diff, err := oldBuilder.Diff(newBuilder)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedEmpty, diff.IsEmpty())
+	}
+}
+
+func TestMachineConfigDiff(t *testing.T) {
+	apiClient := buildTestClientWithDummyMachineConfig()
+
+	oldBuilder := NewMCBuilder(apiClient, "rendered-worker-old").
+		WithFile("/etc/kept", []byte("kept"), 0644, true).
+		WithFile("/etc/changed", []byte("old"), 0644, true).
+		WithFile("/etc/removed", []byte("removed"), 0644, true).
+		WithSystemdUnit("hello.service", "[Unit]\n", true).
+		WithKernelArguments([]string{"nosmt", "audit=0"})
+
+	newBuilder := NewMCBuilder(apiClient, "rendered-worker-new").
+		WithFile("/etc/kept", []byte("kept"), 0644, true).
+		WithFile("/etc/changed", []byte("new"), 0644, true).
+		WithFile("/etc/added", []byte("added"), 0644, true).
+		WithSystemdUnit("hello.service", "[Unit]\n", false).
+		WithDirectory("/var/lib/hello", 0755).
+		WithKernelArguments([]string{"audit=0", "skew_tick=1"}).
+		WithKernelType("realtime")
+
+	diff, err := oldBuilder.Diff(newBuilder)
+	assert.Nil(t, err)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, "+ file /etc/added\n"+
+		"- file /etc/removed\n"+
+		"~ file /etc/changed\n"+
+		"+ directory /var/lib/hello\n"+
+		"~ unit hello.service\n"+
+		"+ kernelArgument skew_tick=1\n"+
+		"- kernelArgument nosmt\n"+
+		`~ kernelType: "" -> "realtime"`, diff.String())
+
+	diff, err = oldBuilder.Diff(oldBuilder)
+	assert.Nil(t, err)
+	assert.True(t, diff.IsEmpty())
+
+	_, err = oldBuilder.Diff(nil)
+	assert.Equal(t, fmt.Errorf("error: received nil MachineConfig builder"), err)
+}
+
+func buildDummyGzipDataURL(t *testing.T, contents string) string {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	writer := gzip.NewWriter(&buffer)
+	_, err := writer.Write([]byte(contents))
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+
+	return "data:;base64," + base64.StdEncoding.EncodeToString(buffer.Bytes())
+}
+
+func buildDummyCompressedIgnitionConfig(t *testing.T, contents, compression string) []byte {
+	t.Helper()
+
+	return []byte(fmt.Sprintf(`{"ignition":{"version":"3.2.0"},"storage":{"files":[{"path":"/etc/hello",`+
+		`"mode":420,"overwrite":true,"contents":{"compression":"%s","source":"%s"}}]}}`,
+		compression, buildDummyGzipDataURL(t, contents)))
+}
@@ -0,0 +1,201 @@
+package mco
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	ign3types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/golang/glog"
+)
+
+// MachineConfigDiff lists the differences between the rendered content of two MachineConfigs. Files and directories
+// are identified by path and systemd units by name.
+type MachineConfigDiff struct {
+	AddedFiles             []string
+	RemovedFiles           []string
+	ChangedFiles           []string
+	AddedDirectories       []string
+	RemovedDirectories     []string
+	ChangedDirectories     []string
+	AddedUnits             []string
+	RemovedUnits           []string
+	ChangedUnits           []string
+	AddedKernelArguments   []string
+	RemovedKernelArguments []string
+	AddedExtensions        []string
+	RemovedExtensions      []string
+	// ChangedFields describes the changes of the other spec fields, such as kernelType, fips and osImageURL.
+	ChangedFields []string
+}
+
+// Diff compares the definition of the MachineConfig with the definition of the other MachineConfig. The result lists
+// what was added, removed or changed in the other MachineConfig.
+func (builder *MCBuilder) Diff(other *MCBuilder) (*MachineConfigDiff, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if valid, err := other.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Comparing machineconfig %s with machineconfig %s", builder.Definition.Name, other.Definition.Name)
+
+	oldConfig, err := builder.GetIgnitionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	newConfig, err := other.GetIgnitionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	oldFiles, err := getIgnitionFiles(oldConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	newFiles, err := getIgnitionFiles(newConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &MachineConfigDiff{}
+
+	diff.AddedFiles, diff.RemovedFiles, diff.ChangedFiles = diffByKey(
+		oldFiles, newFiles, func(file IgnitionFile) string { return file.Path }, isSameFile)
+	diff.AddedDirectories, diff.RemovedDirectories, diff.ChangedDirectories = diffByKey(
+		oldConfig.Storage.Directories, newConfig.Storage.Directories,
+		func(directory ign3types.Directory) string { return directory.Path }, isDeepEqual[ign3types.Directory])
+	diff.AddedUnits, diff.RemovedUnits, diff.ChangedUnits = diffByKey(
+		oldConfig.Systemd.Units, newConfig.Systemd.Units,
+		func(unit ign3types.Unit) string { return unit.Name }, isDeepEqual[ign3types.Unit])
+
+	identity := func(value string) string { return value }
+	diff.AddedKernelArguments, diff.RemovedKernelArguments, _ = diffByKey(
+		builder.Definition.Spec.KernelArguments, other.Definition.Spec.KernelArguments, identity, isDeepEqual[string])
+	diff.AddedExtensions, diff.RemovedExtensions, _ = diffByKey(
+		builder.Definition.Spec.Extensions, other.Definition.Spec.Extensions, identity, isDeepEqual[string])
+
+	oldSpec, newSpec := builder.Definition.Spec, other.Definition.Spec
+
+	for _, field := range []struct {
+		name     string
+		old, new string
+	}{
+		{name: "kernelType", old: oldSpec.KernelType, new: newSpec.KernelType},
+		{name: "fips", old: fmt.Sprint(oldSpec.FIPS), new: fmt.Sprint(newSpec.FIPS)},
+		{name: "osImageURL", old: oldSpec.OSImageURL, new: newSpec.OSImageURL},
+		{
+			name: "baseOSExtensionsContainerImage",
+			old:  oldSpec.BaseOSExtensionsContainerImage,
+			new:  newSpec.BaseOSExtensionsContainerImage,
+		},
+	} {
+		if field.old != field.new {
+			diff.ChangedFields = append(diff.ChangedFields, fmt.Sprintf("%s: %q -> %q", field.name, field.old, field.new))
+		}
+	}
+
+	return diff, nil
+}
+
+// IsEmpty checks whether the compared MachineConfigs have the same rendered content.
+func (diff *MachineConfigDiff) IsEmpty() bool {
+	return diff.String() == ""
+}
+
+// String returns one line per difference, prefixed with + for additions, - for removals and ~ for changes.
+func (diff *MachineConfigDiff) String() string {
+	var lines []string
+
+	for _, section := range []struct {
+		prefix string
+		kind   string
+		values []string
+	}{
+		{prefix: "+", kind: "file", values: diff.AddedFiles},
+		{prefix: "-", kind: "file", values: diff.RemovedFiles},
+		{prefix: "~", kind: "file", values: diff.ChangedFiles},
+		{prefix: "+", kind: "directory", values: diff.AddedDirectories},
+		{prefix: "-", kind: "directory", values: diff.RemovedDirectories},
+		{prefix: "~", kind: "directory", values: diff.ChangedDirectories},
+		{prefix: "+", kind: "unit", values: diff.AddedUnits},
+		{prefix: "-", kind: "unit", values: diff.RemovedUnits},
+		{prefix: "~", kind: "unit", values: diff.ChangedUnits},
+		{prefix: "+", kind: "kernelArgument", values: diff.AddedKernelArguments},
+		{prefix: "-", kind: "kernelArgument", values: diff.RemovedKernelArguments},
+		{prefix: "+", kind: "extension", values: diff.AddedExtensions},
+		{prefix: "-", kind: "extension", values: diff.RemovedExtensions},
+	} {
+		for _, value := range section.values {
+			lines = append(lines, fmt.Sprintf("%s %s %s", section.prefix, section.kind, value))
+		}
+	}
+
+	for _, field := range diff.ChangedFields {
+		lines = append(lines, "~ "+field)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// diffByKey returns the sorted keys of the elements only in newElements, only in oldElements and in both but not
+// equal.
+func diffByKey[T any](
+	oldElements, newElements []T, key func(T) string, equal func(T, T) bool) (added, removed, changed []string) {
+	oldByKey := make(map[string]T, len(oldElements))
+	for _, element := range oldElements {
+		oldByKey[key(element)] = element
+	}
+
+	newByKey := make(map[string]T, len(newElements))
+	for _, element := range newElements {
+		newByKey[key(element)] = element
+	}
+
+	for elementKey, newElement := range newByKey {
+		oldElement, found := oldByKey[elementKey]
+
+		switch {
+		case !found:
+			added = append(added, elementKey)
+		case !equal(oldElement, newElement):
+			changed = append(changed, elementKey)
+		}
+	}
+
+	for elementKey := range oldByKey {
+		if _, found := newByKey[elementKey]; !found {
+			removed = append(removed, elementKey)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+
+	return added, removed, changed
+}
+
+// isSameFile checks whether the files have the same mode, overwrite and contents. Files with data URL sources are
+// compared by decoded contents, since the same contents may be encoded or compressed differently.
+func isSameFile(oldFile, newFile IgnitionFile) bool {
+	if oldFile.Mode != newFile.Mode || oldFile.Overwrite != newFile.Overwrite {
+		return false
+	}
+
+	if oldFile.Source == newFile.Source {
+		return true
+	}
+
+	return oldFile.Contents != nil && newFile.Contents != nil && bytes.Equal(oldFile.Contents, newFile.Contents)
+}
+
+func isDeepEqual[T any](oldElement, newElement T) bool {
+	return reflect.DeepEqual(oldElement, newElement)
+}
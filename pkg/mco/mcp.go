@@ -33,6 +33,8 @@ type MCPBuilder struct {
 	apiClient *clients.Settings
 	// errorMsg is processed before MachineConfigPool object is created.
 	errorMsg string
+	// machineConfigNodeVersion is the first served version of the MachineConfigNode resource, once found.
+	machineConfigNodeVersion string
 }
 
 // MCPAdditionalOptions additional options for mcp object.
@@ -107,6 +109,27 @@ func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
 	return builder, err
 }
 
+// Update renovates the existing MachineConfigPool object with the MachineConfigPool definition in builder.
+func (builder *MCPBuilder) Update() (*MCPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the MachineConfigPool %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("MachineConfigPool %s object does not exist", builder.Definition.Name)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.MachineConfigPools().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
 // Delete removes a MachineConfigPool object from a cluster.
 func (builder *MCPBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
@@ -0,0 +1,40 @@
+package mco
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMCPUpdate(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyMCP("worker")},
+	})
+
+	testBuilder, err := Pull(testSettings, "worker")
+	assert.Nil(t, err)
+
+	testBuilder.Definition.Spec.Paused = true
+
+	testBuilder, err = testBuilder.Update()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Object.Spec.Paused)
+
+	testBuilder = NewMCPBuilder(testSettings, "infra")
+
+	_, err = testBuilder.Update()
+	assert.Equal(t, fmt.Errorf("MachineConfigPool infra object does not exist"), err)
+}
+
+func buildDummyMCP(name string) *mcv1.MachineConfigPool {
+	return &mcv1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
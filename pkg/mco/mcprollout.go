@@ -0,0 +1,371 @@
+package mco
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcdconstants "github.com/openshift/machine-config-operator/pkg/daemon/constants"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	mcoNamespace        = "openshift-machine-config-operator"
+	mcdContainerName    = "machine-config-daemon"
+	mcdPodLabelSelector = "k8s-app=machine-config-daemon"
+	mcdLogTailLines     = 50
+	machineConfigNodes  = "machineconfignodes"
+)
+
+// machineConfigNodeVersions are the versions of the MachineConfigNode resource reporting the per node update phases,
+// from the GA one to the tech preview one served by older clusters.
+var machineConfigNodeVersions = []string{"v1", "v1alpha1"}
+
+// NodeRolloutStatus describes the progress of a single node of a MachineConfigPool towards its target config.
+type NodeRolloutStatus struct {
+	Name          string
+	CurrentConfig string
+	DesiredConfig string
+	// State is the machine-config-daemon state: Working, Done, Degraded or Unreconcilable.
+	State string
+	// Reason is the human readable reason the machine-config-daemon reports for a degraded state.
+	Reason string
+	// Phase is the most recent update phase reported by the MachineConfigNode. It is empty if the cluster does not
+	// support MachineConfigNodes.
+	Phase string
+	// Updated is true if the node runs the target config of the MachineConfigPool.
+	Updated bool
+}
+
+// IsDegraded checks whether the machine-config-daemon of the node failed to apply the desired config.
+func (status NodeRolloutStatus) IsDegraded() bool {
+	return status.State == mcdconstants.MachineConfigDaemonStateDegraded ||
+		status.State == mcdconstants.MachineConfigDaemonStateUnreconcilable
+}
+
+// DegradedNodeExplanation describes why a node of a MachineConfigPool is degraded.
+type DegradedNodeExplanation struct {
+	NodeRolloutStatus
+	// DaemonPod is the name of the machine-config-daemon pod running on the node.
+	DaemonPod string
+	// DaemonLogs are the last lines of the machine-config-daemon logs.
+	DaemonLogs string
+}
+
+// MCPDegradedExplanation describes why a MachineConfigPool is degraded.
+type MCPDegradedExplanation struct {
+	PoolName string
+	Degraded bool
+	// Conditions are the messages of the true Degraded, NodeDegraded and RenderDegraded conditions.
+	Conditions []string
+	Nodes      []DegradedNodeExplanation
+}
+
+// String returns the explanation as human readable text, suitable for test failure messages.
+func (explanation *MCPDegradedExplanation) String() string {
+	if !explanation.Degraded {
+		return fmt.Sprintf("MachineConfigPool %s is not degraded", explanation.PoolName)
+	}
+
+	var output strings.Builder
+
+	fmt.Fprintf(&output, "MachineConfigPool %s is degraded\n", explanation.PoolName)
+
+	for _, condition := range explanation.Conditions {
+		fmt.Fprintf(&output, "  %s\n", condition)
+	}
+
+	for _, node := range explanation.Nodes {
+		fmt.Fprintf(&output, "node %s is %s applying %s: %s\n", node.Name, node.State, node.DesiredConfig, node.Reason)
+
+		if node.DaemonLogs != "" {
+			fmt.Fprintf(&output, "  %s logs:\n", node.DaemonPod)
+
+			for _, line := range strings.Split(strings.TrimRight(node.DaemonLogs, "\n"), "\n") {
+				fmt.Fprintf(&output, "    %s\n", line)
+			}
+		}
+	}
+
+	return output.String()
+}
+
+// Pause pauses the MachineConfigPool so that new configs are not rolled out to its nodes.
+func (builder *MCPBuilder) Pause() error {
+	return builder.setPaused(true)
+}
+
+// Unpause resumes the rollout of configs to the nodes of the MachineConfigPool.
+func (builder *MCPBuilder) Unpause() error {
+	return builder.setPaused(false)
+}
+
+// WithMaxUnavailable sets the number or percentage of nodes of the MachineConfigPool which can be updated at once.
+func (builder *MCPBuilder) WithMaxUnavailable(maxUnavailable intstr.IntOrString) *MCPBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting maxUnavailable of MachineConfigPool %s to %s",
+		builder.Definition.Name, maxUnavailable.String())
+
+	scaledValue, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, 100, true)
+	if err != nil || scaledValue < 1 {
+		glog.V(100).Infof("The maxUnavailable %s is invalid", maxUnavailable.String())
+
+		builder.errorMsg = fmt.Sprintf(
+			"MachineConfigPool 'maxUnavailable' must be a positive number or percentage, got %s", maxUnavailable.String())
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxUnavailable = &maxUnavailable
+
+	return builder
+}
+
+// GetNodeRolloutStatus returns the rollout progress of every node of the MachineConfigPool, derived from the
+// machine-config-daemon annotations of the nodes and from the MachineConfigNodes where the cluster supports them.
+func (builder *MCPBuilder) GetNodeRolloutStatus() ([]NodeRolloutStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting node rollout status of MachineConfigPool %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("MachineConfigPool %s object does not exist", builder.Definition.Name)
+	}
+
+	nodeSelector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.NodeSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nodeSelector of MachineConfigPool %s: %w", builder.Definition.Name, err)
+	}
+
+	nodeList, err := builder.apiClient.CoreV1Interface.Nodes().List(
+		context.TODO(), metav1.ListOptions{LabelSelector: nodeSelector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	targetConfig := builder.Object.Spec.Configuration.Name
+	phases := builder.getMachineConfigNodePhases()
+
+	var nodeStatuses []NodeRolloutStatus
+
+	for _, node := range nodeList.Items {
+		nodeStatus := NodeRolloutStatus{
+			Name:          node.Name,
+			CurrentConfig: node.Annotations[mcdconstants.CurrentMachineConfigAnnotationKey],
+			DesiredConfig: node.Annotations[mcdconstants.DesiredMachineConfigAnnotationKey],
+			State:         node.Annotations[mcdconstants.MachineConfigDaemonStateAnnotationKey],
+			Reason:        node.Annotations[mcdconstants.MachineConfigDaemonReasonAnnotationKey],
+			Phase:         phases[node.Name],
+		}
+
+		nodeStatus.Updated = nodeStatus.CurrentConfig == targetConfig &&
+			nodeStatus.DesiredConfig == targetConfig &&
+			nodeStatus.State == mcdconstants.MachineConfigDaemonStateDone
+
+		nodeStatuses = append(nodeStatuses, nodeStatus)
+	}
+
+	sort.Slice(nodeStatuses, func(i, j int) bool {
+		return nodeStatuses[i].Name < nodeStatuses[j].Name
+	})
+
+	return nodeStatuses, nil
+}
+
+// GetPendingNodes returns the names of the nodes of the MachineConfigPool which do not run its target config yet.
+func (builder *MCPBuilder) GetPendingNodes() ([]string, error) {
+	nodeStatuses, err := builder.GetNodeRolloutStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingNodes []string
+
+	for _, nodeStatus := range nodeStatuses {
+		if !nodeStatus.Updated {
+			pendingNodes = append(pendingNodes, nodeStatus.Name)
+		}
+	}
+
+	return pendingNodes, nil
+}
+
+// ExplainDegraded returns why the MachineConfigPool is degraded: the messages of its degraded conditions and, for
+// every degraded node, the machine-config-daemon error along with the last lines of its logs.
+func (builder *MCPBuilder) ExplainDegraded() (*MCPDegradedExplanation, error) {
+	nodeStatuses, err := builder.GetNodeRolloutStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Explaining degraded state of MachineConfigPool %s", builder.Definition.Name)
+
+	explanation := &MCPDegradedExplanation{PoolName: builder.Definition.Name}
+
+	for _, condition := range builder.Object.Status.Conditions {
+		switch condition.Type {
+		case mcov1.MachineConfigPoolDegraded, mcov1.MachineConfigPoolNodeDegraded, mcov1.MachineConfigPoolRenderDegraded:
+			if condition.Status == isTrue {
+				explanation.Degraded = true
+				explanation.Conditions = append(explanation.Conditions,
+					fmt.Sprintf("%s: %s: %s", condition.Type, condition.Reason, condition.Message))
+			}
+		}
+	}
+
+	for _, nodeStatus := range nodeStatuses {
+		if !nodeStatus.IsDegraded() {
+			continue
+		}
+
+		explanation.Degraded = true
+		explanation.Nodes = append(explanation.Nodes, builder.explainDegradedNode(nodeStatus))
+	}
+
+	return explanation, nil
+}
+
+func (builder *MCPBuilder) setPaused(paused bool) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Setting paused of MachineConfigPool %s to %t", builder.Definition.Name, paused)
+
+	if !builder.Exists() {
+		return fmt.Errorf("MachineConfigPool %s object does not exist", builder.Definition.Name)
+	}
+
+	// Only paused is changed, on a copy of the live pool, so that pending definition changes are neither applied nor
+	// lost.
+	pool := builder.Object.DeepCopy()
+	pool.Spec.Paused = paused
+
+	updatedPool, err := builder.apiClient.MachineConfigPools().Update(context.TODO(), pool, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to set paused to %t on MachineConfigPool %s: %w", paused, builder.Definition.Name, err)
+	}
+
+	builder.Object = updatedPool
+	builder.Definition.Spec.Paused = paused
+	builder.Definition.ResourceVersion = updatedPool.ResourceVersion
+
+	return nil
+}
+
+// explainDegradedNode finds the machine-config-daemon pod of the node and attaches the tail of its logs. Failing to
+// get the logs is reported in place of the logs, since the explanation is still useful without them.
+func (builder *MCPBuilder) explainDegradedNode(nodeStatus NodeRolloutStatus) DegradedNodeExplanation {
+	nodeExplanation := DegradedNodeExplanation{NodeRolloutStatus: nodeStatus}
+
+	daemonPods, err := pod.List(builder.apiClient, mcoNamespace, metav1.ListOptions{
+		LabelSelector: mcdPodLabelSelector,
+		FieldSelector: "spec.nodeName=" + nodeStatus.Name,
+	})
+	if err != nil {
+		nodeExplanation.DaemonLogs = fmt.Sprintf("failed to list machine-config-daemon pods: %v", err)
+
+		return nodeExplanation
+	}
+
+	for _, daemonPod := range daemonPods {
+		if daemonPod.Definition.Spec.NodeName != nodeStatus.Name {
+			continue
+		}
+
+		nodeExplanation.DaemonPod = daemonPod.Definition.Name
+
+		logs, err := builder.apiClient.Pods(mcoNamespace).GetLogs(daemonPod.Definition.Name, &corev1.PodLogOptions{
+			Container: mcdContainerName,
+			TailLines: ptr.To(int64(mcdLogTailLines)),
+		}).DoRaw(context.TODO())
+		if err != nil {
+			nodeExplanation.DaemonLogs = fmt.Sprintf("failed to get machine-config-daemon logs: %v", err)
+
+			return nodeExplanation
+		}
+
+		nodeExplanation.DaemonLogs = strings.TrimRight(string(logs), "\n")
+
+		return nodeExplanation
+	}
+
+	return nodeExplanation
+}
+
+// getMachineConfigNodePhases lists the MachineConfigNodes and returns the update phase of their nodes, indexed by node
+// name. The first served version of the resource is remembered so that the versions are only probed once. Nil is
+// returned if the MachineConfigNodes cannot be listed.
+func (builder *MCPBuilder) getMachineConfigNodePhases() map[string]string {
+	versions := machineConfigNodeVersions
+	if builder.machineConfigNodeVersion != "" {
+		versions = []string{builder.machineConfigNodeVersion}
+	}
+
+	for _, version := range versions {
+		machineConfigNodeList, err := builder.apiClient.Resource(schema.GroupVersionResource{
+			Group: mcov1.GroupName, Version: version, Resource: machineConfigNodes,
+		}).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			glog.V(100).Infof("Failed to list %s MachineConfigNodes: %v", version, err)
+
+			continue
+		}
+
+		builder.machineConfigNodeVersion = version
+		phases := make(map[string]string)
+
+		for index := range machineConfigNodeList.Items {
+			phases[machineConfigNodeList.Items[index].GetName()] = getMachineConfigNodePhase(
+				&machineConfigNodeList.Items[index])
+		}
+
+		return phases
+	}
+
+	return nil
+}
+
+// getMachineConfigNodePhase returns the type of the most recently transitioned true condition of the
+// MachineConfigNode.
+func getMachineConfigNodePhase(machineConfigNode *unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(machineConfigNode.Object, "status", "conditions")
+
+	var (
+		phase              string
+		lastTransitionTime time.Time
+	)
+
+	for _, rawCondition := range conditions {
+		condition, ok := rawCondition.(map[string]interface{})
+		if !ok || condition["status"] != isTrue {
+			continue
+		}
+
+		conditionType, _ := condition["type"].(string)
+		transitionTimestamp, _ := condition["lastTransitionTime"].(string)
+		transitionTime, _ := time.Parse(time.RFC3339, transitionTimestamp)
+
+		if phase == "" || transitionTime.After(lastTransitionTime) {
+			phase = conditionType
+			lastTransitionTime = transitionTime
+		}
+	}
+
+	return phase
+}
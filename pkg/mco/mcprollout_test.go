@@ -0,0 +1,286 @@
+package mco
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcdconstants "github.com/openshift/machine-config-operator/pkg/daemon/constants"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testRenderedOld = "rendered-worker-old"
+	testRenderedNew = "rendered-worker-new"
+)
+
+func TestMCPPauseUnpause(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyMCP("worker")},
+	})
+
+	testBuilder := NewMCPBuilder(testSettings, "worker")
+
+	err := testBuilder.Pause()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Object.Spec.Paused)
+
+	err = testBuilder.Unpause()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Object.Spec.Paused)
+
+	testBuilder.Definition.Spec.MaxUnavailable = ptr.To(intstr.FromInt32(2))
+
+	err = testBuilder.Pause()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Object.Spec.Paused)
+	assert.True(t, testBuilder.Definition.Spec.Paused)
+	assert.Nil(t, testBuilder.Object.Spec.MaxUnavailable)
+	assert.Equal(t, ptr.To(intstr.FromInt32(2)), testBuilder.Definition.Spec.MaxUnavailable)
+	assert.NotSame(t, testBuilder.Definition, testBuilder.Object)
+
+	err = NewMCPBuilder(testSettings, "infra").Pause()
+	assert.Equal(t, fmt.Errorf("MachineConfigPool infra object does not exist"), err)
+}
+
+func TestMCPWithMaxUnavailable(t *testing.T) {
+	testCases := []struct {
+		maxUnavailable intstr.IntOrString
+		expectedError  string
+	}{
+		{
+			maxUnavailable: intstr.FromInt32(2),
+			expectedError:  "",
+		},
+		{
+			maxUnavailable: intstr.FromString("25%"),
+			expectedError:  "",
+		},
+		{
+			maxUnavailable: intstr.FromInt32(0),
+			expectedError:  "MachineConfigPool 'maxUnavailable' must be a positive number or percentage, got 0",
+		},
+		{
+			maxUnavailable: intstr.FromString("two"),
+			expectedError:  "MachineConfigPool 'maxUnavailable' must be a positive number or percentage, got two",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewMCPBuilder(clients.GetTestClients(clients.TestClientParams{}), "worker").
+			WithMaxUnavailable(testCase.maxUnavailable)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, &testCase.maxUnavailable, testBuilder.Definition.Spec.MaxUnavailable)
+		}
+	}
+}
+
+func TestMCPGetNodeRolloutStatus(t *testing.T) {
+	testSettings := buildMCPClientWithMachineConfigNodes(buildDummyRolloutObjects(), machineConfigNodeVersions,
+		buildDummyMachineConfigNode("v1", "worker-1"))
+
+	testBuilder := NewMCPBuilder(testSettings, "worker")
+
+	nodeStatuses, err := testBuilder.GetNodeRolloutStatus()
+	assert.Nil(t, err)
+	assert.Len(t, nodeStatuses, 3)
+
+	assert.Equal(t, NodeRolloutStatus{
+		Name:          "worker-0",
+		CurrentConfig: testRenderedNew,
+		DesiredConfig: testRenderedNew,
+		State:         mcdconstants.MachineConfigDaemonStateDone,
+		Updated:       true,
+	}, nodeStatuses[0])
+	assert.False(t, nodeStatuses[1].Updated)
+	assert.Equal(t, "UpdateExecuted", nodeStatuses[1].Phase)
+	assert.True(t, nodeStatuses[2].IsDegraded())
+
+	pendingNodes, err := testBuilder.GetPendingNodes()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"worker-1", "worker-2"}, pendingNodes)
+
+	_, err = NewMCPBuilder(testSettings, "infra").GetNodeRolloutStatus()
+	assert.Equal(t, fmt.Errorf("MachineConfigPool infra object does not exist"), err)
+}
+
+func TestMCPGetMachineConfigNodePhases(t *testing.T) {
+	testCases := []struct {
+		servedVersions  []string
+		expectedPhases  map[string]string
+		expectedVersion string
+	}{
+		{
+			servedVersions:  []string{"v1", "v1alpha1"},
+			expectedPhases:  map[string]string{"worker-0": "UpdateExecuted"},
+			expectedVersion: "v1",
+		},
+		{
+			servedVersions:  []string{"v1alpha1"},
+			expectedPhases:  map[string]string{"worker-0": "UpdateExecuted"},
+			expectedVersion: "v1alpha1",
+		},
+		{
+			servedVersions:  nil,
+			expectedPhases:  nil,
+			expectedVersion: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var machineConfigNodes []runtime.Object
+		for _, version := range testCase.servedVersions {
+			machineConfigNodes = append(machineConfigNodes, buildDummyMachineConfigNode(version, "worker-0"))
+		}
+
+		testBuilder := NewMCPBuilder(buildMCPClientWithMachineConfigNodes(nil, testCase.servedVersions,
+			machineConfigNodes...), "worker")
+
+		assert.Equal(t, testCase.expectedPhases, testBuilder.getMachineConfigNodePhases())
+		assert.Equal(t, testCase.expectedVersion, testBuilder.machineConfigNodeVersion)
+	}
+}
+
+func TestMCPExplainDegraded(t *testing.T) {
+	testSettings := buildMCPClientWithMachineConfigNodes(buildDummyRolloutObjects(), machineConfigNodeVersions)
+
+	explanation, err := NewMCPBuilder(testSettings, "worker").ExplainDegraded()
+	assert.Nil(t, err)
+	assert.True(t, explanation.Degraded)
+	assert.Equal(t, []string{"NodeDegraded: 1 nodes are reporting degraded status on sync: " +
+		"Node worker-2 is reporting: \"unexpected on-disk state\""}, explanation.Conditions)
+	assert.Len(t, explanation.Nodes, 1)
+	assert.Equal(t, "worker-2", explanation.Nodes[0].Name)
+	assert.Equal(t, "machine-config-daemon-worker-2", explanation.Nodes[0].DaemonPod)
+	assert.Equal(t, "fake logs", explanation.Nodes[0].DaemonLogs)
+	assert.True(t, strings.HasPrefix(explanation.String(), "MachineConfigPool worker is degraded\n"))
+	assert.Contains(t, explanation.String(),
+		"node worker-2 is Degraded applying rendered-worker-new: unexpected on-disk state\n")
+
+	testSettings = buildMCPClientWithMachineConfigNodes(
+		[]runtime.Object{buildDummyMCP("worker")}, machineConfigNodeVersions)
+
+	explanation, err = NewMCPBuilder(testSettings, "worker").ExplainDegraded()
+	assert.Nil(t, err)
+	assert.False(t, explanation.Degraded)
+	assert.Equal(t, "MachineConfigPool worker is not degraded", explanation.String())
+}
+
+func buildDummyRolloutObjects() []runtime.Object {
+	pool := buildDummyMCP("worker")
+	pool.Spec.Configuration.Name = testRenderedNew
+	pool.Spec.NodeSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"node-role.kubernetes.io/worker": ""},
+	}
+	pool.Status.Conditions = []mcv1.MachineConfigPoolCondition{{
+		Type:    mcv1.MachineConfigPoolNodeDegraded,
+		Status:  corev1.ConditionTrue,
+		Reason:  "1 nodes are reporting degraded status on sync",
+		Message: "Node worker-2 is reporting: \"unexpected on-disk state\"",
+	}}
+
+	return []runtime.Object{
+		pool,
+		buildDummyRolloutNode("worker-0", testRenderedNew, testRenderedNew, mcdconstants.MachineConfigDaemonStateDone, ""),
+		buildDummyRolloutNode("worker-1", testRenderedOld, testRenderedNew, mcdconstants.MachineConfigDaemonStateWorking, ""),
+		buildDummyRolloutNode("worker-2", testRenderedOld, testRenderedNew, mcdconstants.MachineConfigDaemonStateDegraded,
+			"unexpected on-disk state"),
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "master-0", Labels: map[string]string{"node-role.kubernetes.io/master": ""},
+		}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "machine-config-daemon-worker-2",
+				Namespace: mcoNamespace,
+				Labels:    map[string]string{"k8s-app": "machine-config-daemon"},
+			},
+			Spec: corev1.PodSpec{NodeName: "worker-2"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "machine-config-daemon-worker-1",
+				Namespace: mcoNamespace,
+				Labels:    map[string]string{"k8s-app": "machine-config-daemon"},
+			},
+			Spec: corev1.PodSpec{NodeName: "worker-1"},
+		},
+	}
+}
+
+func buildDummyRolloutNode(name, currentConfig, desiredConfig, state, reason string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"node-role.kubernetes.io/worker": ""},
+			Annotations: map[string]string{
+				mcdconstants.CurrentMachineConfigAnnotationKey:      currentConfig,
+				mcdconstants.DesiredMachineConfigAnnotationKey:      desiredConfig,
+				mcdconstants.MachineConfigDaemonStateAnnotationKey:  state,
+				mcdconstants.MachineConfigDaemonReasonAnnotationKey: reason,
+			},
+		},
+	}
+}
+
+// buildMCPClientWithMachineConfigNodes returns test clients whose dynamic client serves the MachineConfigNodes in the
+// given versions only, the others being reported as not found like on a cluster which does not serve them.
+func buildMCPClientWithMachineConfigNodes(
+	objects []runtime.Object, servedVersions []string, machineConfigNodes ...runtime.Object) *clients.Settings {
+	listKinds := make(map[schema.GroupVersionResource]string)
+
+	for _, version := range machineConfigNodeVersions {
+		listKinds[schema.GroupVersionResource{
+			Group: mcv1.GroupName, Version: version, Resource: "machineconfignodes"}] = "MachineConfigNodeList"
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(), listKinds, machineConfigNodes...)
+	dynamicClient.PrependReactor("list", "machineconfignodes",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if slices.Contains(servedVersions, action.GetResource().Version) {
+				return false, nil, nil
+			}
+
+			return true, nil, k8serrors.NewNotFound(action.GetResource().GroupResource(), "")
+		})
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects})
+	testSettings.Interface = dynamicClient
+
+	return testSettings
+}
+
+func buildDummyMachineConfigNode(version, nodeName string) *unstructured.Unstructured {
+	machineConfigNode := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type": "Updated", "status": "False", "lastTransitionTime": "2024-01-01T10:00:00Z"},
+				map[string]interface{}{
+					"type": "UpdatePrepared", "status": "True", "lastTransitionTime": "2024-01-01T10:01:00Z"},
+				map[string]interface{}{
+					"type": "UpdateExecuted", "status": "True", "lastTransitionTime": "2024-01-01T10:02:00Z"},
+			},
+		},
+	}}
+	machineConfigNode.SetAPIVersion(mcv1.GroupName + "/" + version)
+	machineConfigNode.SetKind("MachineConfigNode")
+	machineConfigNode.SetName(nodeName)
+
+	return machineConfigNode
+}
@@ -0,0 +1,340 @@
+package nodes
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// DefaultDebugImage is the tools image used by node debug sessions when no image is provided.
+	DefaultDebugImage = "registry.redhat.io/rhel9/support-tools:latest"
+	// DefaultDebugNamespace is the namespace in which node debug pods are created when no namespace is provided.
+	DefaultDebugNamespace = "default"
+
+	debugContainerName   = "container-00"
+	debugHostMountPath   = "/host"
+	debugHostVolumeName  = "host"
+	defaultDebugTimeout  = 5 * time.Minute
+	defaultDebugLifetime = 2 * time.Hour
+)
+
+// DebugSessionOptions provides optional settings for a node debug session.
+type DebugSessionOptions struct {
+	// Image is the tools image used by the debug pod. Defaults to DefaultDebugImage.
+	Image string
+	// Namespace is the namespace in which the debug pod is created. Defaults to DefaultDebugNamespace.
+	Namespace string
+	// Timeout is the time to wait for the debug pod to be running. Defaults to 5 minutes.
+	Timeout time.Duration
+	// MaxLifetime bounds how long the debug pod may live, so that it is cleaned up even if the session is never
+	// closed. Defaults to 2 hours.
+	MaxLifetime time.Duration
+}
+
+// DebugSession represents a long-lived privileged debug pod scheduled on a node, equivalent to oc debug node/<name>.
+// The host filesystem is mounted at /host and commands are executed through chroot.
+type DebugSession struct {
+	// NodeName is the name of the node the session runs on.
+	NodeName string
+	// Pod is the debug pod backing the session.
+	Pod *pod.Builder
+	// options are the settings the session was started with, reused when the session has to be restarted.
+	options *DebugSessionOptions
+}
+
+// NewDebugSession creates a privileged debug pod with hostPID and hostNetwork on the given node and waits until it
+// is running. The pod tolerates all taints so control-plane nodes are supported.
+func NewDebugSession(
+	apiClient *clients.Settings, nodeName string, options *DebugSessionOptions) (*DebugSession, error) {
+	glog.V(100).Infof("Starting debug session on node %s", nodeName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("debug session 'apiClient' cannot be nil")
+	}
+
+	if nodeName == "" {
+		glog.V(100).Infof("The nodeName of the debug session is empty")
+
+		return nil, fmt.Errorf("debug session 'nodeName' cannot be empty")
+	}
+
+	options = applyDebugSessionDefaults(options)
+
+	debugPod, err := newDebugPodBuilder(apiClient, nodeName, options).CreateAndWaitUntilRunning(options.Timeout)
+	if err != nil {
+		glog.V(100).Infof("Failed to start debug pod on node %s: %v", nodeName, err)
+
+		if debugPod != nil && debugPod.Object != nil {
+			_, _ = debugPod.DeleteImmediate()
+		}
+
+		return nil, fmt.Errorf("failed to start debug session on node %s: %w", nodeName, err)
+	}
+
+	return &DebugSession{NodeName: nodeName, Pod: debugPod, options: options}, nil
+}
+
+// ExecOnHost runs the given shell command on the node host through chroot and returns its output.
+func (session *DebugSession) ExecOnHost(command string) (string, error) {
+	if err := session.validate(); err != nil {
+		return "", err
+	}
+
+	glog.V(100).Infof("Executing command %q on host of node %s", command, session.NodeName)
+
+	output, err := session.Pod.ExecCommand(
+		[]string{"chroot", debugHostMountPath, "/bin/bash", "-c", command}, debugContainerName)
+	if err != nil {
+		return output.String(), fmt.Errorf("failed to execute %q on node %s: %w", command, session.NodeName, err)
+	}
+
+	return output.String(), nil
+}
+
+// ReadHostFile returns the content of the file at the given absolute path on the node host.
+func (session *DebugSession) ReadHostFile(hostPath string) ([]byte, error) {
+	if err := session.validate(); err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Reading file %s from host of node %s", hostPath, session.NodeName)
+
+	if !path.IsAbs(hostPath) {
+		return nil, fmt.Errorf("host path %s must be an absolute path", hostPath)
+	}
+
+	content, err := session.Pod.Copy(path.Join(debugHostMountPath, hostPath), debugContainerName, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s from node %s: %w", hostPath, session.NodeName, err)
+	}
+
+	return content.Bytes(), nil
+}
+
+// WriteHostFile writes content with the given mode to the file at the given absolute path on the node host. The
+// checksum of the written file is verified.
+func (session *DebugSession) WriteHostFile(hostPath string, content []byte, mode os.FileMode) error {
+	if err := session.validate(); err != nil {
+		return err
+	}
+
+	glog.V(100).Infof("Writing %d bytes to file %s on host of node %s", len(content), hostPath, session.NodeName)
+
+	if !path.IsAbs(hostPath) {
+		return fmt.Errorf("host path %s must be an absolute path", hostPath)
+	}
+
+	err := session.Pod.UploadBytes(content, path.Join(debugHostMountPath, hostPath), debugContainerName, mode, true)
+	if err != nil {
+		return fmt.Errorf("failed to write file %s on node %s: %w", hostPath, session.NodeName, err)
+	}
+
+	return nil
+}
+
+// IsAlive checks whether the debug pod of the session still exists and is running. The pod stops once its
+// MaxLifetime is reached.
+func (session *DebugSession) IsAlive() bool {
+	if err := session.validate(); err != nil {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if debug session on node %s is alive", session.NodeName)
+
+	return session.Pod.Exists() && session.Pod.Object != nil && session.Pod.Object.Status.Phase == corev1.PodRunning
+}
+
+// Close removes the debug pod and waits until it is deleted.
+func (session *DebugSession) Close() error {
+	if err := session.validate(); err != nil {
+		return err
+	}
+
+	glog.V(100).Infof("Closing debug session on node %s", session.NodeName)
+
+	_, err := session.Pod.DeleteAndWait(time.Minute)
+	if err != nil {
+		return fmt.Errorf("failed to remove debug pod of node %s: %w", session.NodeName, err)
+	}
+
+	return nil
+}
+
+// StartDebugSession starts a debug session on the node which is reused by ExecOnHost, ReadHostFile and
+// WriteHostFile until CloseDebugSession is called. An existing session is returned as long as its pod is running,
+// otherwise its pod is removed and a new session is started, with the options of the previous session when none are
+// provided.
+func (builder *Builder) StartDebugSession(options *DebugSessionOptions) (*DebugSession, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if builder.debugSession != nil {
+		if builder.debugSession.IsAlive() {
+			return builder.debugSession, nil
+		}
+
+		glog.V(100).Infof("Debug session on node %s is no longer running, restarting it", builder.Definition.Name)
+
+		if options == nil {
+			options = builder.debugSession.options
+		}
+
+		if builder.debugSession.Pod.Object != nil {
+			_, err := builder.debugSession.Pod.DeleteImmediate()
+			if err != nil {
+				return nil, fmt.Errorf("failed to remove expired debug pod of node %s: %w", builder.Definition.Name, err)
+			}
+		}
+
+		builder.debugSession = nil
+	}
+
+	session, err := NewDebugSession(builder.apiClient, builder.Definition.Name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.debugSession = session
+
+	return session, nil
+}
+
+// CloseDebugSession removes the debug session of the node, if any.
+func (builder *Builder) CloseDebugSession() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if builder.debugSession == nil {
+		return nil
+	}
+
+	err := builder.debugSession.Close()
+	if err != nil {
+		return err
+	}
+
+	builder.debugSession = nil
+
+	return nil
+}
+
+// ExecOnHost runs the given shell command on the node host using the node debug session. A session with default
+// options is started if none exists.
+func (builder *Builder) ExecOnHost(command string) (string, error) {
+	session, err := builder.StartDebugSession(nil)
+	if err != nil {
+		return "", err
+	}
+
+	return session.ExecOnHost(command)
+}
+
+// ReadHostFile returns the content of the file at the given absolute path on the node host using the node debug
+// session. A session with default options is started if none exists.
+func (builder *Builder) ReadHostFile(hostPath string) ([]byte, error) {
+	session, err := builder.StartDebugSession(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return session.ReadHostFile(hostPath)
+}
+
+// WriteHostFile writes content to the file at the given absolute path on the node host using the node debug
+// session. A session with default options is started if none exists.
+func (builder *Builder) WriteHostFile(hostPath string, content []byte, mode os.FileMode) error {
+	session, err := builder.StartDebugSession(nil)
+	if err != nil {
+		return err
+	}
+
+	return session.WriteHostFile(hostPath, content, mode)
+}
+
+func (session *DebugSession) validate() error {
+	if session == nil || session.Pod == nil {
+		glog.V(100).Infof("The debug session is uninitialized")
+
+		return fmt.Errorf("error: received nil debug session")
+	}
+
+	return nil
+}
+
+func applyDebugSessionDefaults(options *DebugSessionOptions) *DebugSessionOptions {
+	optionsCopy := DebugSessionOptions{}
+
+	if options != nil {
+		optionsCopy = *options
+	}
+
+	if optionsCopy.Image == "" {
+		optionsCopy.Image = DefaultDebugImage
+	}
+
+	if optionsCopy.Namespace == "" {
+		optionsCopy.Namespace = DefaultDebugNamespace
+	}
+
+	if optionsCopy.Timeout == 0 {
+		optionsCopy.Timeout = defaultDebugTimeout
+	}
+
+	if optionsCopy.MaxLifetime == 0 {
+		optionsCopy.MaxLifetime = defaultDebugLifetime
+	}
+
+	return &optionsCopy
+}
+
+func newDebugPodBuilder(apiClient *clients.Settings, nodeName string, options *DebugSessionOptions) *pod.Builder {
+	lifetimeSeconds := int64(options.MaxLifetime.Seconds())
+	podName := strings.ReplaceAll(fmt.Sprintf("%s-debug-%s", nodeName, rand.String(5)), ".", "-")
+
+	debugContainer, err := pod.NewContainerBuilder(debugContainerName, options.Image,
+		[]string{"/bin/sh", "-c", fmt.Sprintf("sleep %d", lifetimeSeconds)}).
+		WithSecurityContext(&corev1.SecurityContext{Privileged: ptr.To(true), RunAsUser: ptr.To(int64(0))}).
+		WithVolumeMount(corev1.VolumeMount{Name: debugHostVolumeName, MountPath: debugHostMountPath}).
+		GetContainerCfg()
+
+	podBuilder := pod.NewBuilder(apiClient, podName, options.Namespace, options.Image)
+
+	if err != nil {
+		return podBuilder.WithOptions(func(builder *pod.Builder) (*pod.Builder, error) {
+			return builder, err
+		})
+	}
+
+	return podBuilder.
+		RedefineDefaultContainer(*debugContainer).
+		DefineOnNode(nodeName).
+		WithHostNetwork().
+		WithHostPid(true).
+		WithRestartPolicy(corev1.RestartPolicyNever).
+		WithToleration(corev1.Toleration{Operator: corev1.TolerationOpExists}).
+		WithVolume(corev1.Volume{
+			Name: debugHostVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+			},
+		}).
+		WithOptions(func(builder *pod.Builder) (*pod.Builder, error) {
+			builder.Definition.Spec.ActiveDeadlineSeconds = &lifetimeSeconds
+
+			return builder, nil
+		})
+}
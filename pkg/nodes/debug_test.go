@@ -0,0 +1,146 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewDebugSessionValidation(t *testing.T) {
+	testCases := []struct {
+		nodeName      string
+		client        bool
+		expectedError error
+	}{
+		{
+			nodeName:      "",
+			client:        true,
+			expectedError: fmt.Errorf("debug session 'nodeName' cannot be empty"),
+		},
+		{
+			nodeName:      "worker-0",
+			client:        false,
+			expectedError: fmt.Errorf("debug session 'apiClient' cannot be nil"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		session, err := NewDebugSession(testSettings, testCase.nodeName, nil)
+		assert.Nil(t, session)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestApplyDebugSessionDefaults(t *testing.T) {
+	options := applyDebugSessionDefaults(nil)
+	assert.Equal(t, DefaultDebugImage, options.Image)
+	assert.Equal(t, DefaultDebugNamespace, options.Namespace)
+	assert.Equal(t, defaultDebugTimeout, options.Timeout)
+	assert.Equal(t, defaultDebugLifetime, options.MaxLifetime)
+
+	customOptions := &DebugSessionOptions{Image: "quay.io/example/tools:latest", Timeout: time.Minute}
+	options = applyDebugSessionDefaults(customOptions)
+	assert.Equal(t, "quay.io/example/tools:latest", options.Image)
+	assert.Equal(t, time.Minute, options.Timeout)
+	assert.Empty(t, customOptions.Namespace)
+}
+
+func TestNewDebugPodBuilder(t *testing.T) {
+	testBuilder := newDebugPodBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		"master-0.example.com", applyDebugSessionDefaults(nil))
+
+	podSpec := testBuilder.Definition.Spec
+
+	assert.Equal(t, "master-0.example.com", podSpec.NodeName)
+	assert.True(t, podSpec.HostNetwork)
+	assert.True(t, podSpec.HostPID)
+	assert.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+	assert.Equal(t, []corev1.Toleration{{Operator: corev1.TolerationOpExists}}, podSpec.Tolerations)
+	assert.Equal(t, int64(defaultDebugLifetime.Seconds()), *podSpec.ActiveDeadlineSeconds)
+	assert.Equal(t, "/", podSpec.Volumes[0].HostPath.Path)
+	assert.Len(t, podSpec.Containers, 1)
+	assert.Equal(t, debugContainerName, podSpec.Containers[0].Name)
+	assert.True(t, *podSpec.Containers[0].SecurityContext.Privileged)
+	assert.Equal(t, debugHostMountPath, podSpec.Containers[0].VolumeMounts[0].MountPath)
+	assert.NotContains(t, testBuilder.Definition.Name, ".")
+}
+
+func TestDebugSessionNil(t *testing.T) {
+	var session *DebugSession
+
+	_, err := session.ExecOnHost("uname -r")
+	assert.Equal(t, fmt.Errorf("error: received nil debug session"), err)
+
+	err = session.Close()
+	assert.Equal(t, fmt.Errorf("error: received nil debug session"), err)
+}
+
+func TestStartDebugSessionReuse(t *testing.T) {
+	testCases := []struct {
+		phase            corev1.PodPhase
+		expectedReused   bool
+		expectedPodExist bool
+	}{
+		{
+			phase:            corev1.PodRunning,
+			expectedReused:   true,
+			expectedPodExist: true,
+		},
+		{
+			phase:            corev1.PodFailed,
+			expectedReused:   false,
+			expectedPodExist: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		debugPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0-debug-abcde", Namespace: DefaultDebugNamespace},
+			Status:     corev1.PodStatus{Phase: testCase.phase},
+		}
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyRebootNode("worker-0"), debugPod},
+		})
+
+		podBuilder, err := pod.Pull(testSettings, debugPod.Name, debugPod.Namespace)
+		assert.Nil(t, err)
+
+		testBuilder, err := Pull(testSettings, "worker-0")
+		assert.Nil(t, err)
+
+		previousSession := &DebugSession{
+			NodeName: "worker-0",
+			Pod:      podBuilder,
+			options:  &DebugSessionOptions{Namespace: DefaultDebugNamespace, Timeout: time.Second},
+		}
+		testBuilder.debugSession = previousSession
+
+		session, err := testBuilder.StartDebugSession(nil)
+
+		if testCase.expectedReused {
+			assert.Nil(t, err)
+			assert.Equal(t, previousSession, session)
+		} else {
+			// The fake client never runs the new debug pod, so restarting the session times out.
+			assert.NotNil(t, err)
+			assert.Nil(t, testBuilder.debugSession)
+		}
+
+		_, err = testSettings.Pods(debugPod.Namespace).Get(context.TODO(), debugPod.Name, metav1.GetOptions{})
+		assert.Equal(t, testCase.expectedPodExist, err == nil)
+	}
+}
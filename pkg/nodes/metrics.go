@@ -0,0 +1,36 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	metricsv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metrics/v1beta1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetMetrics returns the latest resource usage of the node as reported by the metrics.k8s.io API.
+func (builder *Builder) GetMetrics() (*metricsv1beta1.NodeMetrics, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting metrics of node %s", builder.Definition.Name)
+
+	if err := builder.apiClient.AttachScheme(metricsv1beta1.AddToScheme); err != nil {
+		glog.V(100).Infof("Failed to add metrics v1beta1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	nodeMetrics := &metricsv1beta1.NodeMetrics{}
+
+	err := builder.apiClient.Client.Get(
+		context.TODO(), runtimeclient.ObjectKey{Name: builder.Definition.Name}, nodeMetrics)
+	if err != nil {
+		glog.V(100).Infof("Failed to get metrics of node %s due to %s", builder.Definition.Name, err.Error())
+
+		return nil, err
+	}
+
+	return nodeMetrics, nil
+}
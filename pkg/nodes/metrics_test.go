@@ -0,0 +1,83 @@
+package nodes
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metricsv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metrics/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultMetricsNodeName = "worker-0"
+
+func TestNodeGetMetrics(t *testing.T) {
+	testCases := []struct {
+		addMetrics     bool
+		expectedCPU    string
+		expectedMemory string
+		expectedError  string
+	}{
+		{
+			addMetrics:     true,
+			expectedCPU:    "2",
+			expectedMemory: "8Gi",
+			expectedError:  "",
+		},
+		{
+			addMetrics:    false,
+			expectedError: "nodemetricses.metrics.k8s.io \"worker-0\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := buildDummyMetricsNode()
+
+		if testCase.addMetrics {
+			runtimeObjects = append(runtimeObjects, buildDummyNodeMetrics()...)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: []clients.SchemeAttacher{metricsv1beta1.AddToScheme},
+		})
+
+		testBuilder, err := Pull(testSettings, defaultMetricsNodeName)
+		assert.Nil(t, err)
+
+		nodeMetrics, err := testBuilder.GetMetrics()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedCPU, nodeMetrics.Usage.Cpu().String())
+		assert.Equal(t, testCase.expectedMemory, nodeMetrics.Usage.Memory().String())
+	}
+}
+
+func buildDummyMetricsNode() []runtime.Object {
+	return append([]runtime.Object{}, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultMetricsNodeName,
+		},
+	})
+}
+
+func buildDummyNodeMetrics() []runtime.Object {
+	return append([]runtime.Object{}, &metricsv1beta1.NodeMetrics{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultMetricsNodeName,
+		},
+		Usage: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("8Gi"),
+		},
+	})
+}
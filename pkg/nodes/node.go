@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/kubernetes"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
@@ -26,11 +25,12 @@ const (
 
 // Builder provides struct for Node object containing connection to the cluster and the list of Node definitions.
 type Builder struct {
-	Definition  *corev1.Node
-	Object      *corev1.Node
-	apiClient   kubernetes.Interface
-	errorMsg    string
-	drainHelper *drain.Helper
+	Definition   *corev1.Node
+	Object       *corev1.Node
+	apiClient    *clients.Settings
+	errorMsg     string
+	drainHelper  *drain.Helper
+	debugSession *DebugSession
 }
 
 // SetDrainHelper builds drain Helper that contains parameters to control the behaviour of drain.
@@ -55,7 +55,7 @@ func (builder *Builder) SetDrainHelper(
 
 	builder.drainHelper = &drain.Helper{
 		Ctx:    context.TODO(),
-		Client: builder.apiClient,
+		Client: builder.apiClient.K8sClient,
 		// Delete pods that do not declare a controller.
 		Force: force,
 		// GracePeriodSeconds is how long to wait for a pod to terminate.
@@ -118,7 +118,7 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing node object: %s", nodeName)
 
 	builder := Builder{
-		apiClient: apiClient.K8sClient,
+		apiClient: apiClient,
 		Definition: &corev1.Node{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: nodeName,
@@ -151,7 +151,7 @@ func (builder *Builder) Update() (*Builder, error) {
 	builder.Definition.ResourceVersion = ""
 
 	var err error
-	builder.Object, err = builder.apiClient.CoreV1().Nodes().Update(
+	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
@@ -166,7 +166,7 @@ func (builder *Builder) Exists() bool {
 	glog.V(100).Infof("Checking if node %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.apiClient.CoreV1().Nodes().Get(
+	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Get(
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -184,7 +184,7 @@ func (builder *Builder) Delete() error {
 		return fmt.Errorf("node cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.CoreV1().Nodes().Delete(
+	err := builder.apiClient.CoreV1Interface.Nodes().Delete(
 		context.TODO(),
 		builder.Definition.Name,
 		metav1.DeleteOptions{})
@@ -0,0 +1,249 @@
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/bmc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	defaultRebootTimeout = 30 * time.Minute
+	debugRebootCommand   = "systemctl --no-block reboot"
+)
+
+// RebootMethod triggers the reboot of the given node. It must return as soon as the reboot is requested.
+type RebootMethod func(builder *Builder) error
+
+// RebootOptions provides optional settings for a node reboot.
+type RebootOptions struct {
+	// Timeout bounds the time for the node to come back with a new boot ID and become Ready. Defaults to 30 minutes.
+	Timeout time.Duration
+	// Uncordon marks the node as schedulable once it is Ready again.
+	Uncordon bool
+}
+
+// RebootViaDebugPod returns a RebootMethod running systemctl reboot on the node host from a short-lived debug pod.
+func RebootViaDebugPod(options *DebugSessionOptions) RebootMethod {
+	return func(builder *Builder) error {
+		session, err := NewDebugSession(builder.apiClient, builder.Definition.Name, options)
+		if err != nil {
+			return err
+		}
+
+		_, err = session.ExecOnHost(debugRebootCommand)
+
+		// The debug pod cannot terminate gracefully once the node goes down, so it is removed without waiting.
+		_, _ = session.Pod.DeleteImmediate()
+
+		return err
+	}
+}
+
+// RebootViaBMC returns a RebootMethod power cycling the node through its BMC.
+func RebootViaBMC(bmcClient *bmc.BMC) RebootMethod {
+	return func(builder *Builder) error {
+		if bmcClient == nil {
+			return fmt.Errorf("cannot power cycle node %s with nil bmc", builder.Definition.Name)
+		}
+
+		return bmcClient.SystemPowerCycle()
+	}
+}
+
+// RebootViaBMCs returns a RebootMethod power cycling each node through the BMC mapped to its name. It is meant for
+// RollingReboot where every node has its own BMC.
+func RebootViaBMCs(bmcClients map[string]*bmc.BMC) RebootMethod {
+	return func(builder *Builder) error {
+		bmcClient, found := bmcClients[builder.Definition.Name]
+		if !found {
+			return fmt.Errorf("no bmc provided for node %s", builder.Definition.Name)
+		}
+
+		return RebootViaBMC(bmcClient)(builder)
+	}
+}
+
+// Reboot reboots the node using the given method, waits for the node boot ID to change and for the node to be Ready,
+// then optionally uncordons it. It returns the downtime measured from the return of the reboot method until the node
+// is Ready. The timeout of the options bounds the whole wait from that same point.
+// Relying on the boot ID rather than the Ready condition alone detects reboots faster than the node controller.
+func (builder *Builder) Reboot(method RebootMethod, options *RebootOptions) (time.Duration, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	glog.V(100).Infof("Rebooting node %s", builder.Definition.Name)
+
+	if method == nil {
+		return 0, fmt.Errorf("cannot reboot node %s with nil reboot method", builder.Definition.Name)
+	}
+
+	options = applyRebootDefaults(options)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
+	}
+
+	bootID := builder.Object.Status.NodeInfo.BootID
+	if bootID == "" {
+		return 0, fmt.Errorf("node %s does not report a boot ID", builder.Definition.Name)
+	}
+
+	err := method(builder)
+	if err != nil {
+		return 0, fmt.Errorf("failed to request reboot of node %s: %w", builder.Definition.Name, err)
+	}
+
+	// The reboot method may block for a while, e.g. on a BMC, so the clock only starts once it returned.
+	startTime := time.Now()
+	deadline := startTime.Add(options.Timeout)
+
+	// Any debug session of the node did not survive the reboot.
+	builder.debugSession = nil
+
+	err = builder.waitUntilBootIDChanged(bootID, time.Until(deadline))
+	if err != nil {
+		return time.Since(startTime), err
+	}
+
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return time.Since(startTime), fmt.Errorf("timed out after %v waiting for node %s to become Ready after reboot",
+			options.Timeout, builder.Definition.Name)
+	}
+
+	err = builder.WaitUntilReady(remaining)
+	if err != nil {
+		return time.Since(startTime), err
+	}
+
+	downtime := time.Since(startTime)
+
+	glog.V(100).Infof("Node %s was rebooted and is Ready after %v", builder.Definition.Name, downtime)
+
+	if options.Uncordon {
+		builder.Definition.Spec.Unschedulable = builder.Object.Spec.Unschedulable
+
+		err = builder.Uncordon()
+		if err != nil {
+			return downtime, fmt.Errorf("failed to uncordon node %s after reboot: %w", builder.Definition.Name, err)
+		}
+	}
+
+	return downtime, nil
+}
+
+// RollingReboot reboots the given nodes with at most maxParallel nodes rebooting at the same time. No new reboot is
+// started once a node fails to reboot. It returns the downtime of every successfully rebooted node by name.
+func RollingReboot(
+	nodeList []*Builder,
+	method RebootMethod,
+	maxParallel int,
+	options *RebootOptions) (map[string]time.Duration, error) {
+	glog.V(100).Infof("Rebooting %d nodes with at most %d in parallel", len(nodeList), maxParallel)
+
+	if maxParallel < 1 {
+		return nil, fmt.Errorf("rolling reboot maxParallel must be at least 1, got %d", maxParallel)
+	}
+
+	for _, node := range nodeList {
+		if valid, err := node.validate(); !valid {
+			return nil, err
+		}
+	}
+
+	var (
+		waitGroup sync.WaitGroup
+		mutex     sync.Mutex
+		errs      []error
+	)
+
+	downtimes := make(map[string]time.Duration)
+	slots := make(chan struct{}, maxParallel)
+
+	for _, node := range nodeList {
+		slots <- struct{}{}
+
+		mutex.Lock()
+		failed := len(errs) > 0
+		mutex.Unlock()
+
+		if failed {
+			<-slots
+
+			break
+		}
+
+		waitGroup.Add(1)
+
+		go func(node *Builder) {
+			defer waitGroup.Done()
+			defer func() { <-slots }()
+
+			downtime, err := node.Reboot(method, options)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				errs = append(errs, err)
+
+				return
+			}
+
+			downtimes[node.Definition.Name] = downtime
+		}(node)
+	}
+
+	waitGroup.Wait()
+
+	return downtimes, errors.Join(errs...)
+}
+
+// waitUntilBootIDChanged waits for timeout duration or until the node reports a boot ID other than the given one.
+// Errors getting the node are ignored since the API may be unreachable while a control-plane node reboots.
+func (builder *Builder) waitUntilBootIDChanged(bootID string, timeout time.Duration) error {
+	glog.V(100).Infof("Waiting for node %s boot ID to change from %s", builder.Definition.Name, bootID)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), backoff, timeout, true, func(ctx context.Context) (bool, error) {
+			node, err := builder.apiClient.CoreV1Interface.Nodes().Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+			if err != nil {
+				glog.V(100).Infof("Failed to get node %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			builder.Object = node
+
+			return node.Status.NodeInfo.BootID != "" && node.Status.NodeInfo.BootID != bootID, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("node %s boot ID never changed from %s due to %w", builder.Definition.Name, bootID, err)
+}
+
+func applyRebootDefaults(options *RebootOptions) *RebootOptions {
+	optionsCopy := RebootOptions{}
+
+	if options != nil {
+		optionsCopy = *options
+	}
+
+	if optionsCopy.Timeout == 0 {
+		optionsCopy.Timeout = defaultRebootTimeout
+	}
+
+	return &optionsCopy
+}
@@ -0,0 +1,125 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNodeReboot(t *testing.T) {
+	testCases := []struct {
+		method        RebootMethod
+		uncordon      bool
+		unschedulable bool
+		expectedError error
+	}{
+		{
+			method:        fakeRebootMethod("boot-id-1"),
+			uncordon:      true,
+			unschedulable: false,
+			expectedError: nil,
+		},
+		{
+			method:        fakeRebootMethod("boot-id-1"),
+			uncordon:      false,
+			unschedulable: true,
+			expectedError: nil,
+		},
+		{
+			method:   fakeRebootMethod("boot-id-0"),
+			uncordon: false,
+			expectedError: fmt.Errorf("node worker-0 boot ID never changed from boot-id-0 due to %w",
+				context.DeadlineExceeded),
+		},
+		{
+			method: func(builder *Builder) error {
+				return fmt.Errorf("bmc unreachable")
+			},
+			expectedError: fmt.Errorf("failed to request reboot of node worker-0: %w", fmt.Errorf("bmc unreachable")),
+		},
+		{
+			method:        nil,
+			expectedError: fmt.Errorf("cannot reboot node worker-0 with nil reboot method"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyRebootNode("worker-0")},
+		})
+
+		testBuilder, err := Pull(testSettings, "worker-0")
+		assert.Nil(t, err)
+
+		_, err = testBuilder.Reboot(testCase.method, &RebootOptions{Timeout: time.Second, Uncordon: testCase.uncordon})
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, "boot-id-1", testBuilder.Object.Status.NodeInfo.BootID)
+
+			node, err := testSettings.CoreV1Interface.Nodes().Get(context.TODO(), "worker-0", metav1.GetOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.unschedulable, node.Spec.Unschedulable)
+		}
+	}
+}
+
+func TestRollingReboot(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyRebootNode("worker-0"), buildDummyRebootNode("worker-1"), buildDummyRebootNode("worker-2")},
+	})
+
+	nodeList, err := List(testSettings)
+	assert.Nil(t, err)
+
+	downtimes, err := RollingReboot(nodeList, fakeRebootMethod("boot-id-1"), 2, &RebootOptions{Timeout: time.Second})
+	assert.Nil(t, err)
+	assert.Len(t, downtimes, 3)
+
+	_, err = RollingReboot(nodeList, fakeRebootMethod("boot-id-1"), 0, nil)
+	assert.Equal(t, fmt.Errorf("rolling reboot maxParallel must be at least 1, got 0"), err)
+}
+
+func TestRebootViaBMCs(t *testing.T) {
+	testBuilder := &Builder{Definition: buildDummyRebootNode("worker-0")}
+
+	err := RebootViaBMCs(nil)(testBuilder)
+	assert.Equal(t, fmt.Errorf("no bmc provided for node worker-0"), err)
+}
+
+// fakeRebootMethod simulates a cordoned node coming back Ready with the given boot ID.
+func fakeRebootMethod(newBootID string) RebootMethod {
+	return func(builder *Builder) error {
+		node, err := builder.apiClient.CoreV1Interface.Nodes().Get(
+			context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		node.Spec.Unschedulable = true
+		node.Status.NodeInfo.BootID = newBootID
+		node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+
+		_, err = builder.apiClient.CoreV1Interface.Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+
+		return err
+	}
+}
+
+func buildDummyRebootNode(name string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			NodeInfo:   corev1.NodeSystemInfo{BootID: "boot-id-0"},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ResourceList provides typed accessors over the capacity or allocatable resources of a node. Resources missing from
+// the node are reported as zero quantities.
+type ResourceList struct {
+	resources corev1.ResourceList
+}
+
+// List returns the underlying resource list.
+func (resources ResourceList) List() corev1.ResourceList {
+	return resources.resources
+}
+
+// CPU returns the amount of CPU of the node.
+func (resources ResourceList) CPU() *resource.Quantity {
+	return resources.Get(corev1.ResourceCPU)
+}
+
+// Memory returns the amount of memory of the node.
+func (resources ResourceList) Memory() *resource.Quantity {
+	return resources.Get(corev1.ResourceMemory)
+}
+
+// Pods returns the number of pods the node can run.
+func (resources ResourceList) Pods() *resource.Quantity {
+	return resources.Get(corev1.ResourcePods)
+}
+
+// EphemeralStorage returns the amount of ephemeral storage of the node.
+func (resources ResourceList) EphemeralStorage() *resource.Quantity {
+	return resources.Get(corev1.ResourceEphemeralStorage)
+}
+
+// HugePages returns the amount of hugepages of the given page size, such as 2Mi or 1Gi.
+func (resources ResourceList) HugePages(pageSize string) *resource.Quantity {
+	return resources.Get(corev1.ResourceName(corev1.ResourceHugePagesPrefix + pageSize))
+}
+
+// HugePagesBySize returns the amount of hugepages of every page size reported by the node, indexed by page size.
+func (resources ResourceList) HugePagesBySize() map[string]resource.Quantity {
+	hugePages := make(map[string]resource.Quantity)
+
+	for name, quantity := range resources.resources {
+		if pageSize, found := strings.CutPrefix(string(name), corev1.ResourceHugePagesPrefix); found {
+			hugePages[pageSize] = quantity
+		}
+	}
+
+	return hugePages
+}
+
+// Extended returns the amount of the given extended resource, such as an SR-IOV resource name or nvidia.com/gpu.
+func (resources ResourceList) Extended(resourceName string) *resource.Quantity {
+	return resources.Get(corev1.ResourceName(resourceName))
+}
+
+// ExtendedNames returns the sorted names of the extended resources reported by the node.
+func (resources ResourceList) ExtendedNames() []string {
+	var names []string
+
+	for name := range resources.resources {
+		if isExtendedResourceName(name) {
+			names = append(names, string(name))
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Get returns the amount of the given resource, or a zero quantity if the node does not report it.
+func (resources ResourceList) Get(resourceName corev1.ResourceName) *resource.Quantity {
+	if quantity, found := resources.resources[resourceName]; found {
+		return &quantity
+	}
+
+	return &resource.Quantity{}
+}
+
+// Diff returns, for every resource whose amount differs between the other list and this one, the amount of this
+// list minus the amount of the other list.
+func (resources ResourceList) Diff(other ResourceList) map[corev1.ResourceName]resource.Quantity {
+	diff := make(map[corev1.ResourceName]resource.Quantity)
+
+	for name := range resources.resources {
+		addQuantityDiff(diff, name, *resources.Get(name), *other.Get(name))
+	}
+
+	for name := range other.resources {
+		if _, found := resources.resources[name]; !found {
+			addQuantityDiff(diff, name, resource.Quantity{}, *other.Get(name))
+		}
+	}
+
+	return diff
+}
+
+// GetCapacity returns the total resources of the node.
+func (builder *Builder) GetCapacity() (*ResourceList, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting capacity of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
+	}
+
+	return &ResourceList{resources: builder.Object.Status.Capacity}, nil
+}
+
+// GetAllocatable returns the resources of the node available for scheduling.
+func (builder *Builder) GetAllocatable() (*ResourceList, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting allocatable resources of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
+	}
+
+	return &ResourceList{resources: builder.Object.Status.Allocatable}, nil
+}
+
+// WaitUntilAllocatableEquals waits for timeout duration or until the allocatable amount of the given resource equals
+// the expected one. It is meant to follow rollouts changing node resources, such as a PerformanceProfile reserving
+// CPUs and hugepages, or an SriovNetworkNodePolicy or device plugin exposing extended resources.
+func (builder *Builder) WaitUntilAllocatableEquals(
+	resourceName corev1.ResourceName, expected resource.Quantity, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting until allocatable %s of node %s equals %s",
+		resourceName, builder.Definition.Name, expected.String())
+
+	current := &resource.Quantity{}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), backoff, timeout, true, func(ctx context.Context) (bool, error) {
+			allocatable, err := builder.GetAllocatable()
+			if err != nil {
+				glog.V(100).Infof("Failed to get allocatable resources of node %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			current = allocatable.Get(resourceName)
+
+			return current.Cmp(expected) == 0, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("node %s allocatable %s is %s and never became %s due to %w",
+		builder.Definition.Name, resourceName, current.String(), expected.String(), err)
+}
+
+// isExtendedResourceName checks whether the resource is neither a native resource nor hugepages. Extended resources
+// are always domain prefixed, unlike the native ones which are either unprefixed or in the kubernetes.io domain.
+func isExtendedResourceName(name corev1.ResourceName) bool {
+	if strings.HasPrefix(string(name), corev1.ResourceHugePagesPrefix) {
+		return false
+	}
+
+	domain, _, found := strings.Cut(string(name), "/")
+
+	return found && domain != "kubernetes.io" && !strings.HasSuffix(domain, ".kubernetes.io")
+}
+
+func addQuantityDiff(
+	diff map[corev1.ResourceName]resource.Quantity, name corev1.ResourceName, current, previous resource.Quantity) {
+	if current.Cmp(previous) == 0 {
+		return
+	}
+
+	current.Sub(previous)
+	diff[name] = current
+}
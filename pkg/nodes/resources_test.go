@@ -0,0 +1,121 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNodeGetCapacityAndAllocatable(t *testing.T) {
+	testBuilder := buildValidResourcesTestBuilder()
+
+	capacity, err := testBuilder.GetCapacity()
+	assert.Nil(t, err)
+	assert.Equal(t, "64", capacity.CPU().String())
+	assert.Equal(t, "256Gi", capacity.Memory().String())
+	assert.Equal(t, "250", capacity.Pods().String())
+	assert.Equal(t, "500Gi", capacity.EphemeralStorage().String())
+	assert.Equal(t, "8Gi", capacity.HugePages("1Gi").String())
+	assert.Equal(t, "0", capacity.HugePages("2Mi").String())
+	assert.Equal(t, "8", capacity.Extended("openshift.io/sriovnics").String())
+	assert.Equal(t, "0", capacity.Extended("nvidia.com/gpu").String())
+	assert.Equal(t, []string{"openshift.io/sriovnics"}, capacity.ExtendedNames())
+
+	hugePages := capacity.HugePagesBySize()
+	assert.Len(t, hugePages, 1)
+	hugePages1Gi := hugePages["1Gi"]
+	assert.Equal(t, "8Gi", hugePages1Gi.String())
+
+	allocatable, err := testBuilder.GetAllocatable()
+	assert.Nil(t, err)
+	assert.Equal(t, "60", allocatable.CPU().String())
+
+	diff := allocatable.Diff(*capacity)
+	assert.Len(t, diff, 2)
+
+	cpuDiff := diff[corev1.ResourceCPU]
+	assert.Equal(t, "-4", cpuDiff.String())
+
+	sriovDiff := diff["openshift.io/sriovnics"]
+	assert.Equal(t, "-8", sriovDiff.String())
+}
+
+func TestNodeWaitUntilAllocatableEquals(t *testing.T) {
+	testCases := []struct {
+		resourceName  corev1.ResourceName
+		expected      string
+		expectedError error
+	}{
+		{
+			resourceName:  corev1.ResourceCPU,
+			expected:      "60",
+			expectedError: nil,
+		},
+		{
+			resourceName:  "openshift.io/sriovnics",
+			expected:      "0",
+			expectedError: nil,
+		},
+		{
+			resourceName: "openshift.io/sriovnics",
+			expected:     "8",
+			expectedError: fmt.Errorf("node worker-0 allocatable openshift.io/sriovnics is 0 and never became 8 due to %w",
+				context.DeadlineExceeded),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidResourcesTestBuilder()
+
+		err := testBuilder.WaitUntilAllocatableEquals(
+			testCase.resourceName, resource.MustParse(testCase.expected), time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestIsExtendedResourceName(t *testing.T) {
+	assert.True(t, isExtendedResourceName("openshift.io/sriovnics"))
+	assert.True(t, isExtendedResourceName("nvidia.com/gpu"))
+	assert.False(t, isExtendedResourceName(corev1.ResourceCPU))
+	assert.False(t, isExtendedResourceName("hugepages-2Mi"))
+	assert.False(t, isExtendedResourceName("kubernetes.io/batch-cpu"))
+	assert.False(t, isExtendedResourceName("requests.kubernetes.io/cpu"))
+}
+
+// buildValidResourcesTestBuilder returns a builder pulled from a fake cluster with a worker-0 node reporting resources.
+func buildValidResourcesTestBuilder() *Builder {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("64"),
+					corev1.ResourceMemory:           resource.MustParse("256Gi"),
+					corev1.ResourcePods:             resource.MustParse("250"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("500Gi"),
+					"hugepages-1Gi":                 resource.MustParse("8Gi"),
+					"openshift.io/sriovnics":        resource.MustParse("8"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("60"),
+					corev1.ResourceMemory:           resource.MustParse("256Gi"),
+					corev1.ResourcePods:             resource.MustParse("250"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("500Gi"),
+					"hugepages-1Gi":                 resource.MustParse("8Gi"),
+				},
+			},
+		}},
+	})
+
+	testBuilder, _ := Pull(testSettings, "worker-0")
+
+	return testBuilder
+}
@@ -0,0 +1,185 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WithTaint defines the taint placed in the Node spec. A taint with the same key and effect is replaced, matching the
+// behaviour of kubectl taint --overwrite.
+func (builder *Builder) WithTaint(taint corev1.Taint) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding taint %s to node %s", taint.ToString(), builder.Definition.Name)
+
+	if taint.Key == "" {
+		glog.V(100).Infof("Failed to apply taint with an empty key to node %s", builder.Definition.Name)
+
+		builder.errorMsg = "error to set taint with empty key to node"
+
+		return builder
+	}
+
+	if !isValidTaintEffect(taint.Effect) {
+		glog.V(100).Infof("Failed to apply taint with invalid effect %s to node %s", taint.Effect, builder.Definition.Name)
+
+		builder.errorMsg = fmt.Sprintf("invalid taint effect %s: supported effects %v", taint.Effect, supportedTaintEffects())
+
+		return builder
+	}
+
+	for index, existingTaint := range builder.Definition.Spec.Taints {
+		if existingTaint.MatchTaint(&taint) {
+			builder.Definition.Spec.Taints[index] = taint
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Taints = append(builder.Definition.Spec.Taints, taint)
+
+	return builder
+}
+
+// RemoveTaint removes the taints matching the given one from the Node spec. The key is required, while an empty
+// effect or value matches any effect or value.
+func (builder *Builder) RemoveTaint(taint corev1.Taint) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Removing taint %s from node %s", taint.ToString(), builder.Definition.Name)
+
+	if taint.Key == "" {
+		glog.V(100).Infof("Failed to remove taint with an empty key from node %s", builder.Definition.Name)
+
+		builder.errorMsg = "error to remove taint with empty key from node"
+
+		return builder
+	}
+
+	var remainingTaints []corev1.Taint
+
+	for _, existingTaint := range builder.Definition.Spec.Taints {
+		if !taintMatches(existingTaint, taint) {
+			remainingTaints = append(remainingTaints, existingTaint)
+		}
+	}
+
+	builder.Definition.Spec.Taints = remainingTaints
+
+	return builder
+}
+
+// GetTaints returns the taints currently set on the node in the cluster, including the ones set by the node
+// controller such as node.kubernetes.io/unreachable.
+func (builder *Builder) GetTaints() ([]corev1.Taint, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting taints of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Spec.Taints, nil
+}
+
+// HasTaint checks whether the node in the cluster has a taint matching the given one. The key is required, while an
+// empty effect or value matches any effect or value.
+func (builder *Builder) HasTaint(taint corev1.Taint) (bool, error) {
+	taints, err := builder.GetTaints()
+	if err != nil {
+		return false, err
+	}
+
+	for _, existingTaint := range taints {
+		if taintMatches(existingTaint, taint) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// WaitUntilTaintPresent waits for timeout duration or until the node has a taint matching the given one.
+func (builder *Builder) WaitUntilTaintPresent(taint corev1.Taint, timeout time.Duration) error {
+	return builder.waitForTaint(taint, true, timeout)
+}
+
+// WaitUntilTaintAbsent waits for timeout duration or until the node has no taint matching the given one.
+func (builder *Builder) WaitUntilTaintAbsent(taint corev1.Taint, timeout time.Duration) error {
+	return builder.waitForTaint(taint, false, timeout)
+}
+
+// waitForTaint waits for timeout duration or until the presence of the matching taint is the expected one.
+func (builder *Builder) waitForTaint(taint corev1.Taint, present bool, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting until taint %s is present=%t on node %s",
+		taint.ToString(), present, builder.Definition.Name)
+
+	if taint.Key == "" {
+		return fmt.Errorf("cannot wait for taint with empty key on node %s", builder.Definition.Name)
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			hasTaint, err := builder.HasTaint(taint)
+			if err != nil {
+				return false, err
+			}
+
+			return hasTaint == present, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("node %s taint %s never became present=%t due to %w",
+		builder.Definition.Name, taint.ToString(), present, err)
+}
+
+// taintMatches checks whether the existing taint matches the wanted one. The keys must be equal, while an empty
+// effect or value in the wanted taint matches any effect or value.
+func taintMatches(existingTaint, wantedTaint corev1.Taint) bool {
+	if existingTaint.Key != wantedTaint.Key {
+		return false
+	}
+
+	if wantedTaint.Effect != "" && existingTaint.Effect != wantedTaint.Effect {
+		return false
+	}
+
+	return wantedTaint.Value == "" || existingTaint.Value == wantedTaint.Value
+}
+
+func isValidTaintEffect(effect corev1.TaintEffect) bool {
+	for _, supportedEffect := range supportedTaintEffects() {
+		if effect == supportedEffect {
+			return true
+		}
+	}
+
+	return false
+}
+
+func supportedTaintEffects() []corev1.TaintEffect {
+	return []corev1.TaintEffect{
+		corev1.TaintEffectNoSchedule,
+		corev1.TaintEffectPreferNoSchedule,
+		corev1.TaintEffectNoExecute,
+	}
+}
@@ -0,0 +1,167 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	testNoScheduleTaint  = corev1.Taint{Key: "example.com/dedicated", Value: "infra", Effect: corev1.TaintEffectNoSchedule}
+	testUnreachableTaint = corev1.Taint{Key: corev1.TaintNodeUnreachable, Effect: corev1.TaintEffectNoExecute}
+)
+
+func TestNodeWithTaint(t *testing.T) {
+	testCases := []struct {
+		taint          corev1.Taint
+		expectedTaints []corev1.Taint
+		expectedErrMsg string
+	}{
+		{
+			taint:          testUnreachableTaint,
+			expectedTaints: []corev1.Taint{testNoScheduleTaint, testUnreachableTaint},
+			expectedErrMsg: "",
+		},
+		{
+			taint: corev1.Taint{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+			expectedTaints: []corev1.Taint{
+				{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+			expectedErrMsg: "",
+		},
+		{
+			taint:          corev1.Taint{Effect: corev1.TaintEffectNoSchedule},
+			expectedTaints: []corev1.Taint{testNoScheduleTaint},
+			expectedErrMsg: "error to set taint with empty key to node",
+		},
+		{
+			taint:          corev1.Taint{Key: "example.com/dedicated", Effect: "NoRun"},
+			expectedTaints: []corev1.Taint{testNoScheduleTaint},
+			expectedErrMsg: fmt.Sprintf("invalid taint effect NoRun: supported effects %v", supportedTaintEffects()),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTaintTestBuilder(testNoScheduleTaint)
+		testBuilder.WithTaint(testCase.taint)
+
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		assert.Equal(t, testCase.expectedTaints, testBuilder.Definition.Spec.Taints)
+	}
+}
+
+func TestNodeRemoveTaint(t *testing.T) {
+	testCases := []struct {
+		taint          corev1.Taint
+		expectedTaints []corev1.Taint
+		expectedErrMsg string
+	}{
+		{
+			taint:          corev1.Taint{Key: corev1.TaintNodeUnreachable},
+			expectedTaints: []corev1.Taint{testNoScheduleTaint},
+			expectedErrMsg: "",
+		},
+		{
+			taint:          corev1.Taint{Key: "example.com/dedicated", Value: "gpu"},
+			expectedTaints: []corev1.Taint{testNoScheduleTaint, testUnreachableTaint},
+			expectedErrMsg: "",
+		},
+		{
+			taint:          corev1.Taint{Key: "example.com/dedicated", Effect: corev1.TaintEffectNoExecute},
+			expectedTaints: []corev1.Taint{testNoScheduleTaint, testUnreachableTaint},
+			expectedErrMsg: "",
+		},
+		{
+			taint:          corev1.Taint{Value: "infra"},
+			expectedTaints: []corev1.Taint{testNoScheduleTaint, testUnreachableTaint},
+			expectedErrMsg: "error to remove taint with empty key from node",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTaintTestBuilder(testNoScheduleTaint, testUnreachableTaint)
+		testBuilder.RemoveTaint(testCase.taint)
+
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		assert.Equal(t, testCase.expectedTaints, testBuilder.Definition.Spec.Taints)
+	}
+}
+
+func TestNodeGetTaints(t *testing.T) {
+	testBuilder := buildValidTaintTestBuilder(testUnreachableTaint)
+
+	taints, err := testBuilder.GetTaints()
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.Taint{testUnreachableTaint}, taints)
+
+	hasTaint, err := testBuilder.HasTaint(corev1.Taint{Key: corev1.TaintNodeUnreachable})
+	assert.Nil(t, err)
+	assert.True(t, hasTaint)
+
+	hasTaint, err = testBuilder.HasTaint(corev1.Taint{Key: corev1.TaintNodeUnreachable, Effect: "NoSchedule"})
+	assert.Nil(t, err)
+	assert.False(t, hasTaint)
+}
+
+func TestNodeWaitUntilTaint(t *testing.T) {
+	testCases := []struct {
+		present       bool
+		taint         corev1.Taint
+		expectedError error
+	}{
+		{
+			present:       true,
+			taint:         corev1.Taint{Key: corev1.TaintNodeUnreachable},
+			expectedError: nil,
+		},
+		{
+			present:       false,
+			taint:         corev1.Taint{Key: corev1.TaintNodeNotReady},
+			expectedError: nil,
+		},
+		{
+			present: true,
+			taint:   corev1.Taint{Key: corev1.TaintNodeNotReady},
+			expectedError: fmt.Errorf("node worker-0 taint node.kubernetes.io/not-ready never became present=true due to %w",
+				context.DeadlineExceeded),
+		},
+		{
+			present:       false,
+			taint:         corev1.Taint{},
+			expectedError: fmt.Errorf("cannot wait for taint with empty key on node worker-0"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTaintTestBuilder(testUnreachableTaint)
+
+		var err error
+		if testCase.present {
+			err = testBuilder.WaitUntilTaintPresent(testCase.taint, time.Second)
+		} else {
+			err = testBuilder.WaitUntilTaintAbsent(testCase.taint, time.Second)
+		}
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+// buildValidTaintTestBuilder returns a builder pulled from a fake cluster with a worker-0 node having the given taints.
+func buildValidTaintTestBuilder(taints ...corev1.Taint) *Builder {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+			Spec:       corev1.NodeSpec{Taints: taints},
+		}},
+	})
+
+	testBuilder, _ := Pull(testSettings, "worker-0")
+
+	return testBuilder
+}
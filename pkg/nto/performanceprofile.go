@@ -9,12 +9,16 @@ import (
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/runtimeclass"
 	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// runtimeClassPrefix is prepended by the operator to the PerformanceProfile name to build the RuntimeClass name.
+const runtimeClassPrefix = "performance-"
+
 // Builder provides a struct for PerformanceProfile object from the cluster and a PerformanceProfile definition.
 type Builder struct {
 	// PerformanceProfile definition, used to create the PerformanceProfile object.
@@ -438,6 +442,28 @@ func (builder *Builder) Get() (*v2.PerformanceProfile, error) {
 	return module, nil
 }
 
+// GetRuntimeClass returns the RuntimeClass created by the operator for the PerformanceProfile. The name is taken
+// from the PerformanceProfile status and falls back to the performance-<profile name> naming used by the operator.
+func (builder *Builder) GetRuntimeClass() (*runtimeclass.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting RuntimeClass of PerformanceProfile %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("PerformanceProfile object %s does not exist", builder.Definition.Name)
+	}
+
+	runtimeClassName := runtimeClassPrefix + builder.Definition.Name
+
+	if builder.Object.Status.RuntimeClass != nil && *builder.Object.Status.RuntimeClass != "" {
+		runtimeClassName = *builder.Object.Status.RuntimeClass
+	}
+
+	return runtimeclass.Pull(builder.apiClient, runtimeClassName)
+}
+
 // Delete removes the PerformanceProfile.
 func (builder *Builder) Delete() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
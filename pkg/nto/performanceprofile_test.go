@@ -8,6 +8,7 @@ import (
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/stretchr/testify/assert"
+	nodev1 "k8s.io/api/node/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -790,6 +791,61 @@ func TestPerformanceProfileWithAdditionalKernelArgs(t *testing.T) {
 	}
 }
 
+func TestPerformanceProfileGetRuntimeClass(t *testing.T) {
+	statusRuntimeClass := "custom-runtimeclass"
+
+	testCases := []struct {
+		statusRuntimeClass *string
+		runtimeClassName   string
+		addProfile         bool
+		expectedError      error
+	}{
+		{
+			runtimeClassName: "performance-default",
+			addProfile:       true,
+		},
+		{
+			statusRuntimeClass: &statusRuntimeClass,
+			runtimeClassName:   statusRuntimeClass,
+			addProfile:         true,
+		},
+		{
+			runtimeClassName: "other",
+			addProfile:       true,
+			expectedError:    fmt.Errorf("runtimeclass object performance-default does not exist"),
+		},
+		{
+			runtimeClassName: "performance-default",
+			addProfile:       false,
+			expectedError:    fmt.Errorf("PerformanceProfile object default does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := []runtime.Object{&nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{Name: testCase.runtimeClassName},
+			Handler:    "high-performance",
+		}}
+
+		if testCase.addProfile {
+			runtimeObjects = append(runtimeObjects, &v2.PerformanceProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultPerformanceProfileName},
+				Status:     v2.PerformanceProfileStatus{RuntimeClass: testCase.statusRuntimeClass},
+			})
+		}
+
+		testBuilder := buildValidPerformanceProfileBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}))
+
+		runtimeClassBuilder, err := testBuilder.GetRuntimeClass()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.runtimeClassName, runtimeClassBuilder.Object.Name)
+		}
+	}
+}
+
 func buildValidPerformanceProfileBuilder(apiClient *clients.Settings) *Builder {
 	performanceProfileBuilder := NewBuilder(
 		apiClient,
@@ -2,8 +2,12 @@ package pod
 
 import (
 	"fmt"
+	"path"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/golang/glog"
 	corev1 "k8s.io/api/core/v1"
@@ -411,6 +415,208 @@ func (builder *ContainerBuilder) WithPorts(ports []corev1.ContainerPort) *Contai
 	return builder
 }
 
+// WithLivenessProbe sets the probe the kubelet uses to decide when to restart the container.
+func (builder *ContainerBuilder) WithLivenessProbe(probe *corev1.Probe) *ContainerBuilder {
+	glog.V(100).Infof("Applying liveness probe to container: %v", probe)
+
+	builder.setProbe(&builder.definition.LivenessProbe, probe, "liveness", true)
+
+	return builder
+}
+
+// WithReadinessProbe sets the probe the kubelet uses to decide when the container can receive traffic.
+func (builder *ContainerBuilder) WithReadinessProbe(probe *corev1.Probe) *ContainerBuilder {
+	glog.V(100).Infof("Applying readiness probe to container: %v", probe)
+
+	builder.setProbe(&builder.definition.ReadinessProbe, probe, "readiness", false)
+
+	return builder
+}
+
+// WithStartupProbe sets the probe the kubelet uses to decide when the container has started. The other probes are
+// not run until the startup probe succeeds.
+func (builder *ContainerBuilder) WithStartupProbe(probe *corev1.Probe) *ContainerBuilder {
+	glog.V(100).Infof("Applying startup probe to container: %v", probe)
+
+	builder.setProbe(&builder.definition.StartupProbe, probe, "startup", true)
+
+	return builder
+}
+
+// WithPostStartHook sets the handler executed right after the container is created.
+func (builder *ContainerBuilder) WithPostStartHook(handler *corev1.LifecycleHandler) *ContainerBuilder {
+	glog.V(100).Infof("Applying postStart hook to container: %v", handler)
+
+	if err := validateLifecycleHandler(handler); err != nil {
+		glog.V(100).Infof("Container's postStart hook is invalid: %s", err.Error())
+
+		builder.errorMsg = fmt.Sprintf("container's postStart hook is invalid: %s", err.Error())
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.lifecycle().PostStart = handler
+
+	return builder
+}
+
+// WithPreStopHook sets the handler executed before the container is terminated.
+func (builder *ContainerBuilder) WithPreStopHook(handler *corev1.LifecycleHandler) *ContainerBuilder {
+	glog.V(100).Infof("Applying preStop hook to container: %v", handler)
+
+	if err := validateLifecycleHandler(handler); err != nil {
+		glog.V(100).Infof("Container's preStop hook is invalid: %s", err.Error())
+
+		builder.errorMsg = fmt.Sprintf("container's preStop hook is invalid: %s", err.Error())
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.lifecycle().PreStop = handler
+
+	return builder
+}
+
+// WithEnvFromSecret exposes all keys of the secret as environment variables of the container. The optional prefix
+// is prepended to every variable name.
+func (builder *ContainerBuilder) WithEnvFromSecret(secretName, prefix string) *ContainerBuilder {
+	glog.V(100).Infof("Applying environment variables from secret %s with prefix %s to container", secretName, prefix)
+
+	if secretName == "" {
+		glog.V(100).Infof("Container's envFrom 'secretName' is empty")
+
+		builder.errorMsg = "container's envFrom 'secretName' is empty"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.definition.EnvFrom = append(builder.definition.EnvFrom, corev1.EnvFromSource{
+		Prefix: prefix,
+		SecretRef: &corev1.SecretEnvSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+		},
+	})
+
+	return builder
+}
+
+// WithEnvFromConfigMap exposes all keys of the configmap as environment variables of the container. The optional
+// prefix is prepended to every variable name.
+func (builder *ContainerBuilder) WithEnvFromConfigMap(configMapName, prefix string) *ContainerBuilder {
+	glog.V(100).Infof("Applying environment variables from configmap %s with prefix %s to container",
+		configMapName, prefix)
+
+	if configMapName == "" {
+		glog.V(100).Infof("Container's envFrom 'configMapName' is empty")
+
+		builder.errorMsg = "container's envFrom 'configMapName' is empty"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.definition.EnvFrom = append(builder.definition.EnvFrom, corev1.EnvFromSource{
+		Prefix: prefix,
+		ConfigMapRef: &corev1.ConfigMapEnvSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+		},
+	})
+
+	return builder
+}
+
+// WithEnvVarFromFieldRef adds an environment variable whose value is taken from a field of the pod, for example
+// spec.nodeName, status.podIP or metadata.labels['app']. Whole label and annotation maps are only available through
+// downward API volumes.
+func (builder *ContainerBuilder) WithEnvVarFromFieldRef(name, fieldPath string) *ContainerBuilder {
+	glog.V(100).Infof("Applying environment variable %s from field %s to container", name, fieldPath)
+
+	if name == "" {
+		glog.V(100).Infof("Container's environment var 'name' is empty")
+
+		builder.errorMsg = "container's environment var 'name' is empty"
+	}
+
+	if !isSupportedFieldPath(fieldPath) {
+		glog.V(100).Infof("Container's environment var 'fieldPath' %s is not supported", fieldPath)
+
+		builder.errorMsg = fmt.Sprintf("container's environment var 'fieldPath' %s is not supported", fieldPath)
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.definition.Env = append(builder.definition.Env, corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: fieldPath},
+		},
+	})
+
+	return builder
+}
+
+// WithWorkingDir sets the working directory of the container. The path must be absolute.
+func (builder *ContainerBuilder) WithWorkingDir(workingDir string) *ContainerBuilder {
+	glog.V(100).Infof("Applying working directory to container: %s", workingDir)
+
+	if !path.IsAbs(workingDir) {
+		glog.V(100).Infof("Container's working directory %s is not an absolute path", workingDir)
+
+		builder.errorMsg = fmt.Sprintf("container's working directory '%s' is not an absolute path", workingDir)
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.definition.WorkingDir = workingDir
+
+	return builder
+}
+
+// WithTTY allocates a TTY for the container. A TTY requires stdin to be enabled with WithStdin.
+func (builder *ContainerBuilder) WithTTY(tty bool) *ContainerBuilder {
+	glog.V(100).Infof("Applying tty %t to container", tty)
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.definition.TTY = tty
+
+	return builder
+}
+
+// WithStdin keeps a stdin buffer open for the container. If stdinOnce is set, the stdin channel is closed after
+// the first attached client disconnects.
+func (builder *ContainerBuilder) WithStdin(stdin, stdinOnce bool) *ContainerBuilder {
+	glog.V(100).Infof("Applying stdin %t and stdinOnce %t to container", stdin, stdinOnce)
+
+	if stdinOnce && !stdin {
+		glog.V(100).Infof("Container's stdinOnce requires stdin")
+
+		builder.errorMsg = "container's stdinOnce requires stdin to be enabled"
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.definition.Stdin = stdin
+	builder.definition.StdinOnce = stdinOnce
+
+	return builder
+}
+
 // GetContainerCfg returns Container struct.
 func (builder *ContainerBuilder) GetContainerCfg() (*corev1.Container, error) {
 	glog.V(100).Infof("Returning configuration for container %s", builder.definition.Name)
@@ -421,6 +627,18 @@ func (builder *ContainerBuilder) GetContainerCfg() (*corev1.Container, error) {
 		return nil, fmt.Errorf(builder.errorMsg)
 	}
 
+	if err := validateProbePorts(builder.definition); err != nil {
+		glog.V(100).Infof("Failed to build container configuration due to %s", err.Error())
+
+		return nil, err
+	}
+
+	if builder.definition.TTY && !builder.definition.Stdin {
+		glog.V(100).Infof("Container's tty requires stdin")
+
+		return nil, fmt.Errorf("container's tty requires stdin to be enabled")
+	}
+
 	return builder.definition, nil
 }
 
@@ -497,3 +715,141 @@ func capabilitiesDifference(aCaps, bCaps []corev1.Capability) []corev1.Capabilit
 
 	return resultCaps
 }
+
+// setProbe validates the probe and stores it in the given container probe field.
+func (builder *ContainerBuilder) setProbe(target **corev1.Probe, probe *corev1.Probe, probeType string,
+	requireSingleSuccess bool) {
+	if err := validateProbe(probe, requireSingleSuccess); err != nil {
+		glog.V(100).Infof("Container's %s probe is invalid: %s", probeType, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("container's %s probe is invalid: %s", probeType, err.Error())
+	}
+
+	if builder.errorMsg != "" {
+		return
+	}
+
+	*target = probe
+}
+
+// lifecycle returns the lifecycle of the container definition, initializing it if needed.
+func (builder *ContainerBuilder) lifecycle() *corev1.Lifecycle {
+	if builder.definition.Lifecycle == nil {
+		builder.definition.Lifecycle = &corev1.Lifecycle{}
+	}
+
+	return builder.definition.Lifecycle
+}
+
+func validateProbe(probe *corev1.Probe, requireSingleSuccess bool) error {
+	if probe == nil {
+		return fmt.Errorf("probe cannot be empty")
+	}
+
+	handlers := 0
+
+	for _, isSet := range []bool{
+		probe.Exec != nil, probe.HTTPGet != nil, probe.TCPSocket != nil, probe.GRPC != nil} {
+		if isSet {
+			handlers++
+		}
+	}
+
+	if handlers != 1 {
+		return fmt.Errorf("probe must have exactly one handler, found %d", handlers)
+	}
+
+	if probe.InitialDelaySeconds < 0 || probe.TimeoutSeconds < 0 || probe.PeriodSeconds < 0 ||
+		probe.SuccessThreshold < 0 || probe.FailureThreshold < 0 {
+		return fmt.Errorf("probe timings and thresholds cannot be negative")
+	}
+
+	if requireSingleSuccess && probe.SuccessThreshold > 1 {
+		return fmt.Errorf("successThreshold must be 1")
+	}
+
+	return nil
+}
+
+func validateLifecycleHandler(handler *corev1.LifecycleHandler) error {
+	if handler == nil {
+		return fmt.Errorf("handler cannot be empty")
+	}
+
+	handlers := 0
+
+	for _, isSet := range []bool{
+		handler.Exec != nil, handler.HTTPGet != nil, handler.TCPSocket != nil, handler.Sleep != nil} {
+		if isSet {
+			handlers++
+		}
+	}
+
+	if handlers != 1 {
+		return fmt.Errorf("handler must have exactly one action, found %d", handlers)
+	}
+
+	return nil
+}
+
+// validateProbePorts checks that the ports used by the probes of the container are declared by the container,
+// either by number or by name.
+func validateProbePorts(container *corev1.Container) error {
+	probes := map[string]*corev1.Probe{
+		"liveness":  container.LivenessProbe,
+		"readiness": container.ReadinessProbe,
+		"startup":   container.StartupProbe,
+	}
+
+	for _, probeType := range []string{"liveness", "readiness", "startup"} {
+		probe := probes[probeType]
+		if probe == nil {
+			continue
+		}
+
+		var probePort *intstr.IntOrString
+
+		switch {
+		case probe.HTTPGet != nil:
+			probePort = &probe.HTTPGet.Port
+		case probe.TCPSocket != nil:
+			probePort = &probe.TCPSocket.Port
+		case probe.GRPC != nil:
+			probePort = ptr.To(intstr.FromInt32(probe.GRPC.Port))
+		default:
+			continue
+		}
+
+		if !isDeclaredPort(container.Ports, *probePort) {
+			return fmt.Errorf("container's %s probe port %s is not declared in the container ports",
+				probeType, probePort.String())
+		}
+	}
+
+	return nil
+}
+
+func isDeclaredPort(ports []corev1.ContainerPort, port intstr.IntOrString) bool {
+	for _, containerPort := range ports {
+		if port.Type == intstr.String && containerPort.Name == port.StrVal {
+			return true
+		}
+
+		if port.Type == intstr.Int && containerPort.ContainerPort == port.IntVal {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSupportedFieldPath(fieldPath string) bool {
+	switch fieldPath {
+	case "metadata.name", "metadata.namespace", "metadata.uid", "spec.nodeName", "spec.serviceAccountName",
+		"status.hostIP", "status.hostIPs", "status.podIP", "status.podIPs":
+		return true
+	}
+
+	return strings.HasPrefix(fieldPath, "metadata.labels['") && strings.HasSuffix(fieldPath, "']") ||
+		strings.HasPrefix(fieldPath, "metadata.annotations['") && strings.HasSuffix(fieldPath, "']")
+}
@@ -0,0 +1,140 @@
+package pod
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestContainerWithProbes(t *testing.T) {
+	httpProbe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")},
+		},
+	}
+	tcpProbe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(8080)}},
+	}
+
+	testCases := []struct {
+		mutate         func(builder *ContainerBuilder) *ContainerBuilder
+		expectedErrMsg string
+	}{
+		{
+			mutate: func(builder *ContainerBuilder) *ContainerBuilder {
+				return builder.WithPorts([]corev1.ContainerPort{{Name: "http", ContainerPort: 8080}}).
+					WithLivenessProbe(httpProbe).WithReadinessProbe(tcpProbe).WithStartupProbe(httpProbe)
+			},
+		},
+		{
+			mutate: func(builder *ContainerBuilder) *ContainerBuilder {
+				return builder.WithReadinessProbe(tcpProbe)
+			},
+			expectedErrMsg: "container's readiness probe port 8080 is not declared in the container ports",
+		},
+		{
+			mutate: func(builder *ContainerBuilder) *ContainerBuilder {
+				return builder.WithLivenessProbe(&corev1.Probe{})
+			},
+			expectedErrMsg: "container's liveness probe is invalid: probe must have exactly one handler, found 0",
+		},
+		{
+			mutate: func(builder *ContainerBuilder) *ContainerBuilder {
+				return builder.WithStartupProbe(&corev1.Probe{
+					ProbeHandler:     corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"true"}}},
+					SuccessThreshold: 2,
+				})
+			},
+			expectedErrMsg: "container's startup probe is invalid: successThreshold must be 1",
+		},
+		{
+			mutate: func(builder *ContainerBuilder) *ContainerBuilder {
+				return builder.WithReadinessProbe(nil)
+			},
+			expectedErrMsg: "container's readiness probe is invalid: probe cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.mutate(buildValidContainterBuilder())
+
+		container, err := testBuilder.GetContainerCfg()
+		if testCase.expectedErrMsg == "" {
+			assert.Nil(t, err)
+			assert.Equal(t, httpProbe, container.LivenessProbe)
+			assert.Equal(t, tcpProbe, container.ReadinessProbe)
+			assert.Equal(t, httpProbe, container.StartupProbe)
+		} else {
+			assert.Equal(t, fmt.Errorf(testCase.expectedErrMsg), err)
+		}
+	}
+}
+
+func TestContainerWithLifecycleHooks(t *testing.T) {
+	preStop := &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", "sleep 5"}}}
+	postStart := &corev1.LifecycleHandler{Sleep: &corev1.SleepAction{Seconds: 1}}
+
+	testBuilder := buildValidContainterBuilder().WithPreStopHook(preStop).WithPostStartHook(postStart)
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, preStop, testBuilder.definition.Lifecycle.PreStop)
+	assert.Equal(t, postStart, testBuilder.definition.Lifecycle.PostStart)
+
+	testBuilder = buildValidContainterBuilder().WithPreStopHook(&corev1.LifecycleHandler{
+		Exec:    &corev1.ExecAction{Command: []string{"true"}},
+		HTTPGet: &corev1.HTTPGetAction{Port: intstr.FromInt32(80)},
+	})
+	assert.Equal(t, "container's preStop hook is invalid: handler must have exactly one action, found 2",
+		testBuilder.errorMsg)
+
+	testBuilder = buildValidContainterBuilder().WithPostStartHook(nil)
+	assert.Equal(t, "container's postStart hook is invalid: handler cannot be empty", testBuilder.errorMsg)
+}
+
+func TestContainerWithEnvFrom(t *testing.T) {
+	testBuilder := buildValidContainterBuilder().
+		WithEnvFromSecret("test-secret", "").
+		WithEnvFromConfigMap("test-configmap", "CFG_").
+		WithEnvVarFromFieldRef("NODE_NAME", "spec.nodeName").
+		WithEnvVarFromFieldRef("APP", "metadata.labels['app']")
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.definition.EnvFrom, 2)
+	assert.Equal(t, "test-secret", testBuilder.definition.EnvFrom[0].SecretRef.Name)
+	assert.Equal(t, "CFG_", testBuilder.definition.EnvFrom[1].Prefix)
+	assert.Equal(t, "test-configmap", testBuilder.definition.EnvFrom[1].ConfigMapRef.Name)
+	assert.Len(t, testBuilder.definition.Env, 2)
+	assert.Equal(t, "spec.nodeName", testBuilder.definition.Env[0].ValueFrom.FieldRef.FieldPath)
+
+	testBuilder = buildValidContainterBuilder().WithEnvFromSecret("", "")
+	assert.Equal(t, "container's envFrom 'secretName' is empty", testBuilder.errorMsg)
+
+	testBuilder = buildValidContainterBuilder().WithEnvFromConfigMap("", "")
+	assert.Equal(t, "container's envFrom 'configMapName' is empty", testBuilder.errorMsg)
+
+	testBuilder = buildValidContainterBuilder().WithEnvVarFromFieldRef("NODE_IP", "status.nodeIP")
+	assert.Equal(t, "container's environment var 'fieldPath' status.nodeIP is not supported", testBuilder.errorMsg)
+
+	testBuilder = buildValidContainterBuilder().WithEnvVarFromFieldRef("LABELS", "metadata.labels")
+	assert.Equal(t, "container's environment var 'fieldPath' metadata.labels is not supported", testBuilder.errorMsg)
+}
+
+func TestContainerWithWorkingDirAndTTY(t *testing.T) {
+	container, err := buildValidContainterBuilder().WithWorkingDir("/tmp").WithStdin(true, true).WithTTY(true).
+		GetContainerCfg()
+	assert.Nil(t, err)
+	assert.Equal(t, "/tmp", container.WorkingDir)
+	assert.True(t, container.TTY)
+	assert.True(t, container.Stdin)
+	assert.True(t, container.StdinOnce)
+
+	testBuilder := buildValidContainterBuilder().WithWorkingDir("tmp")
+	assert.Equal(t, "container's working directory 'tmp' is not an absolute path", testBuilder.errorMsg)
+
+	testBuilder = buildValidContainterBuilder().WithStdin(false, true)
+	assert.Equal(t, "container's stdinOnce requires stdin to be enabled", testBuilder.errorMsg)
+
+	_, err = buildValidContainterBuilder().WithTTY(true).GetContainerCfg()
+	assert.Equal(t, fmt.Errorf("container's tty requires stdin to be enabled"), err)
+}
@@ -0,0 +1,167 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const defaultDebugContainerTimeout = 2 * time.Minute
+
+// DebugContainerOptions provides optional settings for an ephemeral debug container.
+type DebugContainerOptions struct {
+	// Name of the debug container. A random name prefixed with debugger- is used when empty.
+	Name string
+	// TargetContainer is the name of the container whose process namespace the debug container joins.
+	TargetContainer string
+	// Privileged runs the debug container in privileged mode.
+	Privileged bool
+	// Timeout is the time to wait for the debug container to be running. Defaults to 2 minutes.
+	Timeout time.Duration
+}
+
+// AttachDebugContainer adds an ephemeral debug container to the running pod using the ephemeralcontainers subresource
+// and waits until it is running. It returns the name of the debug container which can then be used with ExecCommand,
+// GetLog or GetFullLog.
+func (builder *Builder) AttachDebugContainer(
+	image string, cmd []string, options *DebugContainerOptions) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	options = copyDebugContainerOptions(options)
+
+	if options.Name == "" {
+		options.Name = fmt.Sprintf("debugger-%s", rand.String(5))
+	}
+
+	if options.Timeout == 0 {
+		options.Timeout = defaultDebugContainerTimeout
+	}
+
+	glog.V(100).Infof("Attaching debug container %s with image %s and cmd %v to pod %s in namespace %s",
+		options.Name, image, cmd, builder.Definition.Name, builder.Definition.Namespace)
+
+	if image == "" {
+		glog.V(100).Infof("The image of the debug container is empty")
+
+		return "", fmt.Errorf("debug container 'image' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		glog.V(100).Infof("Pod %s in namespace %s does not exist", builder.Definition.Name, builder.Definition.Namespace)
+
+		return "", fmt.Errorf("cannot attach debug container to pod %s which does not exist", builder.Definition.Name)
+	}
+
+	if options.TargetContainer != "" && !hasContainer(builder.Object.Spec.Containers, options.TargetContainer) {
+		glog.V(100).Infof("Target container %s not found in pod %s", options.TargetContainer, builder.Object.Name)
+
+		return "", fmt.Errorf("target container %s does not exist in pod %s",
+			options.TargetContainer, builder.Object.Name)
+	}
+
+	for _, ephemeralContainer := range builder.Object.Spec.EphemeralContainers {
+		if ephemeralContainer.Name == options.Name {
+			return "", fmt.Errorf("ephemeral container %s already exists in pod %s", options.Name, builder.Object.Name)
+		}
+	}
+
+	debugContainer := corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     options.Name,
+			Image:                    image,
+			Command:                  cmd,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			Stdin:                    true,
+			TTY:                      true,
+		},
+		TargetContainerName: options.TargetContainer,
+	}
+
+	if options.Privileged {
+		debugContainer.SecurityContext = &corev1.SecurityContext{Privileged: &trueVar}
+	}
+
+	podCopy := builder.Object.DeepCopy()
+	podCopy.Spec.EphemeralContainers = append(podCopy.Spec.EphemeralContainers, debugContainer)
+
+	updatedPod, err := builder.apiClient.Pods(builder.Definition.Namespace).UpdateEphemeralContainers(
+		context.TODO(), builder.Definition.Name, podCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to add debug container %s to pod %s: %w", options.Name, builder.Object.Name, err)
+	}
+
+	builder.Object = updatedPod
+
+	err = builder.WaitUntilEphemeralContainerRunning(options.Name, options.Timeout)
+	if err != nil {
+		return "", err
+	}
+
+	return options.Name, nil
+}
+
+// WaitUntilEphemeralContainerRunning waits for the duration of the defined timeout or until the given ephemeral
+// container is running. An error is returned early if the container terminates.
+func (builder *Builder) WaitUntilEphemeralContainerRunning(containerName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for ephemeral container %s in pod %s in namespace %s to be running",
+		containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			updatedPod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, nil
+			}
+
+			builder.Object = updatedPod
+
+			for _, status := range updatedPod.Status.EphemeralContainerStatuses {
+				if status.Name != containerName {
+					continue
+				}
+
+				if status.State.Terminated != nil {
+					return false, fmt.Errorf("ephemeral container %s terminated with reason %s: %s",
+						containerName, status.State.Terminated.Reason, status.State.Terminated.Message)
+				}
+
+				return status.State.Running != nil, nil
+			}
+
+			return false, nil
+		})
+}
+
+func hasContainer(containers []corev1.Container, containerName string) bool {
+	for _, container := range containers {
+		if container.Name == containerName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyDebugContainerOptions(options *DebugContainerOptions) *DebugContainerOptions {
+	if options == nil {
+		return &DebugContainerOptions{}
+	}
+
+	optionsCopy := *options
+
+	return &optionsCopy
+}
@@ -0,0 +1,102 @@
+package pod
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAttachDebugContainer(t *testing.T) {
+	testCases := []struct {
+		image         string
+		podExists     bool
+		state         corev1.ContainerState
+		options       *DebugContainerOptions
+		expectedError error
+	}{
+		{
+			image:     "registry.example.com/debug:latest",
+			podExists: true,
+			state:     corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			options:   &DebugContainerOptions{Name: "debugger", TargetContainer: "test"},
+		},
+		{
+			image:         "",
+			podExists:     true,
+			options:       &DebugContainerOptions{Name: "debugger"},
+			expectedError: fmt.Errorf("debug container 'image' cannot be empty"),
+		},
+		{
+			image:         "registry.example.com/debug:latest",
+			podExists:     false,
+			options:       &DebugContainerOptions{Name: "debugger"},
+			expectedError: fmt.Errorf("cannot attach debug container to pod test-pod which does not exist"),
+		},
+		{
+			image:         "registry.example.com/debug:latest",
+			podExists:     true,
+			options:       &DebugContainerOptions{Name: "debugger", TargetContainer: "missing"},
+			expectedError: fmt.Errorf("target container missing does not exist in pod test-pod"),
+		},
+		{
+			image:     "registry.example.com/debug:latest",
+			podExists: true,
+			state: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: "Error", Message: "exec failed"}},
+			options:       &DebugContainerOptions{Name: "debugger", Timeout: time.Second},
+			expectedError: fmt.Errorf("ephemeral container debugger terminated with reason Error: exec failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.podExists {
+			runtimeObjects = append(runtimeObjects, buildDebugTargetPod(testCase.state))
+		}
+
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}),
+			"test-pod", "test-ns", "test-image")
+
+		containerName, err := testBuilder.AttachDebugContainer(
+			testCase.image, []string{"/bin/sh"}, testCase.options)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.options.Name, containerName)
+			assert.Len(t, testBuilder.Object.Spec.EphemeralContainers, 1)
+			assert.Equal(t, "test", testBuilder.Object.Spec.EphemeralContainers[0].TargetContainerName)
+		}
+	}
+}
+
+func TestCopyDebugContainerOptions(t *testing.T) {
+	assert.Equal(t, &DebugContainerOptions{}, copyDebugContainerOptions(nil))
+
+	options := &DebugContainerOptions{Name: "debugger"}
+	optionsCopy := copyDebugContainerOptions(options)
+	optionsCopy.Name = "changed"
+
+	assert.Equal(t, "debugger", options.Name)
+}
+
+func buildDebugTargetPod(state corev1.ContainerState) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: "test-ns",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "test", Image: "test-image"}},
+		},
+		Status: corev1.PodStatus{
+			EphemeralContainerStatuses: []corev1.ContainerStatus{{Name: "debugger", State: state}},
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// EvictionBlockedError is returned by Evict when the eviction is rejected because it would violate a
+// PodDisruptionBudget. Callers can detect it with errors.As and retry once the budget allows a disruption.
+type EvictionBlockedError struct {
+	// PodName is the name of the pod that could not be evicted.
+	PodName string
+	// Namespace is the namespace of the pod that could not be evicted.
+	Namespace string
+	// Err is the error returned by the eviction API.
+	Err error
+}
+
+// Error returns the message of the EvictionBlockedError.
+func (evictionErr *EvictionBlockedError) Error() string {
+	return fmt.Sprintf("eviction of pod %s in namespace %s is blocked by a pod disruption budget: %s",
+		evictionErr.PodName, evictionErr.Namespace, evictionErr.Err.Error())
+}
+
+// Unwrap returns the error returned by the eviction API.
+func (evictionErr *EvictionBlockedError) Unwrap() error {
+	return evictionErr.Err
+}
+
+// Evict removes the pod through the policy/v1 Eviction subresource, which honours the PodDisruptionBudgets
+// selecting the pod, unlike Delete and DeleteImmediate. A negative gracePeriod keeps the grace period of the pod. If
+// the eviction is rejected by a PodDisruptionBudget an *EvictionBlockedError is returned.
+func (builder *Builder) Evict(gracePeriod time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Evicting pod %s in namespace %s with grace period %s",
+		builder.Definition.Name, builder.Definition.Namespace, gracePeriod)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("pod cannot be evicted because it does not exist")
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.Object.Name,
+			Namespace: builder.Object.Namespace,
+		},
+	}
+
+	if gracePeriod >= 0 {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: ptr.To(int64(gracePeriod.Seconds()))}
+	}
+
+	err := builder.apiClient.K8sClient.PolicyV1().Evictions(builder.Definition.Namespace).Evict(
+		context.TODO(), eviction)
+	if err != nil {
+		if k8serrors.IsTooManyRequests(err) {
+			glog.V(100).Infof("Eviction of pod %s is blocked by a pod disruption budget", builder.Definition.Name)
+
+			return builder, &EvictionBlockedError{
+				PodName:   builder.Definition.Name,
+				Namespace: builder.Definition.Namespace,
+				Err:       err,
+			}
+		}
+
+		return builder, fmt.Errorf("can not evict pod: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
@@ -0,0 +1,103 @@
+package pod
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	defaultPodName      = "test-pod"
+	defaultPodNamespace = "test-namespace"
+	defaultPodImage     = "test-image"
+)
+
+func TestPodEvict(t *testing.T) {
+	testCases := []struct {
+		testSettings  *clients.Settings
+		evictionError error
+		expectedError string
+		blocked       bool
+	}{
+		{
+			testSettings:  buildPodClientWithDummyObject(),
+			evictionError: nil,
+			expectedError: "",
+			blocked:       false,
+		},
+		{
+			testSettings:  clients.GetTestClients(clients.TestClientParams{}),
+			evictionError: nil,
+			expectedError: "pod cannot be evicted because it does not exist",
+			blocked:       false,
+		},
+		{
+			testSettings: buildPodClientWithDummyObject(),
+			evictionError: k8serrors.NewTooManyRequests(
+				"Cannot evict pod as it would violate the pod's disruption budget.", 10),
+			expectedError: "eviction of pod test-pod in namespace test-namespace is blocked by a pod disruption " +
+				"budget: Cannot evict pod as it would violate the pod's disruption budget.",
+			blocked: true,
+		},
+		{
+			testSettings:  buildPodClientWithDummyObject(),
+			evictionError: k8serrors.NewInternalError(errors.New("boom")),
+			expectedError: "can not evict pod: Internal error occurred: boom",
+			blocked:       false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.evictionError != nil {
+			fakeClient, ok := testCase.testSettings.K8sClient.(*k8sfake.Clientset)
+			assert.True(t, ok)
+
+			fakeClient.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return action.GetSubresource() == "eviction", nil, testCase.evictionError
+			})
+		}
+
+		testBuilder, err := buildValidPodBuilder(testCase.testSettings).Evict(time.Second)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+			assert.Nil(t, testBuilder.Object)
+
+			continue
+		}
+
+		assert.EqualError(t, err, testCase.expectedError)
+
+		var blockedErr *EvictionBlockedError
+
+		assert.Equal(t, testCase.blocked, errors.As(err, &blockedErr))
+	}
+}
+
+func buildValidPodBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultPodName, defaultPodNamespace, defaultPodImage)
+}
+
+func buildPodClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyPod(),
+	})
+}
+
+func buildDummyPod() []runtime.Object {
+	return append([]runtime.Object{}, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultPodName,
+			Namespace: defaultPodNamespace,
+		},
+	})
+}
@@ -136,6 +136,84 @@ func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string)
 	return podObjects, nil
 }
 
+// ListControlledBy returns the pods in the namespace of the owner that match the given selector and whose
+// controller is the given owner, such as a statefulset, daemonset, replicaset or job.
+func ListControlledBy(
+	apiClient *clients.Settings, owner metav1.Object, selector *metav1.LabelSelector) ([]*Builder, error) {
+	if owner == nil {
+		glog.V(100).Infof("pod 'owner' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list pods, 'owner' parameter is empty")
+	}
+
+	glog.V(100).Infof("Listing pods controlled by %s in the nsname %s", owner.GetName(), owner.GetNamespace())
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		glog.V(100).Infof("Failed to parse selector of %s due to %s", owner.GetName(), err.Error())
+
+		return nil, err
+	}
+
+	podList, err := List(apiClient, owner.GetNamespace(), metav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var podObjects []*Builder
+
+	for _, podBuilder := range podList {
+		if metav1.IsControlledBy(podBuilder.Object, owner) {
+			podObjects = append(podObjects, podBuilder)
+		}
+	}
+
+	return podObjects, nil
+}
+
+// GetNodePlacement returns the names of the given pods grouped by the node they are scheduled on. Pods that are not
+// scheduled yet are grouped under an empty node name.
+func GetNodePlacement(pods []*Builder) map[string][]string {
+	placement := make(map[string][]string)
+
+	for _, podBuilder := range pods {
+		if podBuilder == nil || podBuilder.Object == nil {
+			continue
+		}
+
+		nodeName := podBuilder.Object.Spec.NodeName
+		placement[nodeName] = append(placement[nodeName], podBuilder.Object.Name)
+	}
+
+	return placement
+}
+
+// GetRestartCounts returns the total number of container restarts of each of the given pods keyed by pod name.
+// Init container restarts are included.
+func GetRestartCounts(pods []*Builder) map[string]int32 {
+	restartCounts := make(map[string]int32)
+
+	for _, podBuilder := range pods {
+		if podBuilder == nil || podBuilder.Object == nil {
+			continue
+		}
+
+		var restarts int32
+
+		for _, status := range podBuilder.Object.Status.InitContainerStatuses {
+			restarts += status.RestartCount
+		}
+
+		for _, status := range podBuilder.Object.Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+
+		restartCounts[podBuilder.Object.Name] = restarts
+	}
+
+	return restartCounts
+}
+
 // WaitForAllPodsInNamespaceRunning wait until all pods in namespace that match options are in running state.
 func WaitForAllPodsInNamespaceRunning(
 	apiClient *clients.Settings,
@@ -0,0 +1,73 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestListControlledBy(t *testing.T) {
+	owner := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rs", Namespace: "test-ns", UID: types.UID("test-uid")},
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		buildOwnedTestPod("owned", "test-uid", "worker-0", 0),
+		buildOwnedTestPod("foreign", "other-uid", "worker-0", 0),
+	}})
+
+	pods, err := ListControlledBy(
+		testSettings, owner, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}})
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "owned", pods[0].Object.Name)
+
+	_, err = ListControlledBy(testSettings, nil, nil)
+	assert.EqualError(t, err, "failed to list pods, 'owner' parameter is empty")
+}
+
+func TestGetNodePlacementAndRestartCounts(t *testing.T) {
+	pods := []*Builder{
+		{Object: buildOwnedTestPod("pod-a", "test-uid", "worker-0", 1)},
+		{Object: buildOwnedTestPod("pod-b", "test-uid", "worker-1", 0)},
+		{Object: buildOwnedTestPod("pod-c", "test-uid", "worker-0", 3)},
+		{Object: buildOwnedTestPod("pod-d", "test-uid", "", 0)},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"worker-0": {"pod-a", "pod-c"},
+		"worker-1": {"pod-b"},
+		"":         {"pod-d"},
+	}, GetNodePlacement(pods))
+
+	assert.Equal(t, map[string]int32{"pod-a": 2, "pod-b": 0, "pod-c": 6, "pod-d": 0}, GetRestartCounts(pods))
+}
+
+func buildOwnedTestPod(name, ownerUID, nodeName string, restarts int32) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+			Labels:    map[string]string{"app": "test"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "test-rs",
+				UID:        types.UID(ownerUID),
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "init", RestartCount: restarts}},
+			ContainerStatuses:     []corev1.ContainerStatus{{Name: "test", RestartCount: restarts}},
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package pod
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// LogLine represents a single line of container log received from a log stream.
+type LogLine struct {
+	// Name of the container the line originates from.
+	Container string
+	// Log line without the trailing newline.
+	Text string
+	// Err is set on the last line delivered for the container when its stream failed before ending, for example
+	// because a line exceeded maxLogLineSize. Text is empty in that case.
+	Err error
+}
+
+// maxLogLineSize bounds the length of a single log line read from a log stream.
+const maxLogLineSize = 1024 * 1024
+
+// GetLogStream opens a log stream for the given container. When follow is set the stream stays open and new log
+// lines are delivered as they are written. The caller is responsible for closing the returned stream.
+func (builder *Builder) GetLogStream(containerName string, follow bool) (io.ReadCloser, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Opening log stream for container %s of pod %s in namespace %s with follow %t",
+		containerName, builder.Definition.Name, builder.Definition.Namespace, follow)
+
+	return builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name,
+		&corev1.PodLogOptions{Container: containerName, Follow: follow}).Stream(context.TODO())
+}
+
+// GetPreviousLog fetches the log of the previously terminated instance of the given container.
+func (builder *Builder) GetPreviousLog(containerName string) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	glog.V(100).Infof("Fetching previous log of container %s in pod %s in namespace %s",
+		containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	logStream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name,
+		&corev1.PodLogOptions{Container: containerName, Previous: true}).Stream(context.TODO())
+
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		_ = logStream.Close()
+	}()
+
+	logBuffer := new(bytes.Buffer)
+	_, err = io.Copy(logBuffer, logStream)
+
+	if err != nil {
+		return "", err
+	}
+
+	return logBuffer.String(), nil
+}
+
+// StreamLogLines follows the logs of the given containers, or all containers of the pod when none are provided, and
+// delivers them line by line on the returned channel. The channel is closed once every stream ends or the context is
+// cancelled, which makes it suitable for collecting logs for the whole duration of a test.
+func (builder *Builder) StreamLogLines(ctx context.Context, containerNames ...string) (<-chan LogLine, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if ctx == nil {
+		glog.V(100).Infof("The context used to stream logs is nil")
+
+		return nil, fmt.Errorf("cannot stream logs with nil context")
+	}
+
+	if len(containerNames) == 0 {
+		for _, container := range builder.Definition.Spec.Containers {
+			containerNames = append(containerNames, container.Name)
+		}
+	}
+
+	if len(containerNames) == 0 {
+		glog.V(100).Infof("Pod %s in namespace %s has no containers to stream logs from",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, fmt.Errorf("pod %s has no containers to stream logs from", builder.Definition.Name)
+	}
+
+	glog.V(100).Infof("Streaming logs of containers %v in pod %s in namespace %s",
+		containerNames, builder.Definition.Name, builder.Definition.Namespace)
+
+	var streams []io.ReadCloser
+
+	for _, containerName := range containerNames {
+		stream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name,
+			&corev1.PodLogOptions{Container: containerName, Follow: true}).Stream(ctx)
+
+		if err != nil {
+			for _, openStream := range streams {
+				_ = openStream.Close()
+			}
+
+			return nil, fmt.Errorf("failed to open log stream for container %s: %w", containerName, err)
+		}
+
+		streams = append(streams, stream)
+	}
+
+	lines := make(chan LogLine)
+
+	var waitGroup sync.WaitGroup
+
+	for index, stream := range streams {
+		waitGroup.Add(1)
+
+		go func(containerName string, stream io.ReadCloser) {
+			defer waitGroup.Done()
+
+			defer func() {
+				_ = stream.Close()
+			}()
+
+			scanLogLines(ctx, containerName, stream, lines)
+		}(containerNames[index], stream)
+	}
+
+	go func() {
+		waitGroup.Wait()
+		close(lines)
+	}()
+
+	return lines, nil
+}
+
+// WaitForLogLine follows the log of the given container until a line matches the regular expression or the timeout
+// is reached. It returns the matching line together with its submatches.
+func (builder *Builder) WaitForLogLine(
+	pattern, containerName string, timeout time.Duration) (string, []string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", nil, err
+	}
+
+	glog.V(100).Infof("Waiting up to %s for log line matching %s in container %s of pod %s in namespace %s",
+		timeout, pattern, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	expression, err := regexp.Compile(pattern)
+	if err != nil {
+		glog.V(100).Infof("Failed to compile log pattern %s: %v", pattern, err)
+
+		return "", nil, fmt.Errorf("invalid log pattern %s: %w", pattern, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	defer cancel()
+
+	lines, err := builder.StreamLogLines(ctx, containerName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for line := range lines {
+		if line.Err != nil {
+			return "", nil, fmt.Errorf("failed to read log of container %s in pod %s: %w",
+				containerName, builder.Definition.Name, line.Err)
+		}
+
+		if submatches := expression.FindStringSubmatch(line.Text); submatches != nil {
+			glog.V(100).Infof("Found log line matching %s: %s", pattern, line.Text)
+
+			return line.Text, submatches[1:], nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return "", nil, fmt.Errorf("timed out waiting for log line matching %s in container %s of pod %s: %w",
+			pattern, containerName, builder.Definition.Name, ctx.Err())
+	}
+
+	return "", nil, fmt.Errorf("log stream of container %s in pod %s ended without line matching %s",
+		containerName, builder.Definition.Name, pattern)
+}
+
+// scanLogLines delivers the lines read from the stream until it ends or the context is cancelled. A read failure is
+// delivered as a final line carrying the error, unless it was caused by the cancellation of the context.
+func scanLogLines(ctx context.Context, containerName string, stream io.Reader, lines chan<- LogLine) {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+
+	for scanner.Scan() {
+		select {
+		case lines <- LogLine{Container: containerName, Text: scanner.Text()}:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		glog.V(100).Infof("Failed to read log stream of container %s: %v", containerName, err)
+
+		select {
+		case lines <- LogLine{Container: containerName, Err: err}:
+		case <-ctx.Done():
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package pod
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLogStream(t *testing.T) {
+	testBuilder := NewBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image")
+
+	stream, err := testBuilder.GetLogStream("test", true)
+	assert.Nil(t, err)
+
+	content, err := io.ReadAll(stream)
+	assert.Nil(t, err)
+	assert.Equal(t, "fake logs", string(content))
+	assert.Nil(t, stream.Close())
+}
+
+func TestGetPreviousLog(t *testing.T) {
+	testBuilder := NewBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image")
+
+	log, err := testBuilder.GetPreviousLog("test")
+	assert.Nil(t, err)
+	assert.Equal(t, "fake logs", log)
+}
+
+func TestStreamLogLines(t *testing.T) {
+	testBuilder := NewBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image")
+
+	lines, err := testBuilder.StreamLogLines(context.TODO())
+	assert.Nil(t, err)
+
+	var received []LogLine
+	for line := range lines {
+		received = append(received, line)
+	}
+
+	assert.Equal(t, []LogLine{{Container: "test", Text: "fake logs"}}, received)
+
+	//nolint:staticcheck
+	_, err = testBuilder.StreamLogLines(nil)
+	assert.EqualError(t, err, "cannot stream logs with nil context")
+}
+
+func TestScanLogLines(t *testing.T) {
+	testCases := []struct {
+		log           string
+		expectedTexts []string
+		expectedError error
+	}{
+		{
+			log:           "first\n" + strings.Repeat("a", 128*1024) + "\nlast\n",
+			expectedTexts: []string{"first", strings.Repeat("a", 128*1024), "last"},
+			expectedError: nil,
+		},
+		{
+			log:           "first\n" + strings.Repeat("a", maxLogLineSize+1) + "\n",
+			expectedTexts: []string{"first", ""},
+			expectedError: bufio.ErrTooLong,
+		},
+		{
+			log:           "first\n",
+			expectedTexts: []string{"first"},
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		lines := make(chan LogLine)
+
+		go func() {
+			scanLogLines(context.TODO(), "test", strings.NewReader(testCase.log), lines)
+			close(lines)
+		}()
+
+		var (
+			texts   []string
+			lineErr error
+		)
+
+		for line := range lines {
+			texts = append(texts, line.Text)
+
+			if line.Err != nil {
+				lineErr = line.Err
+			}
+		}
+
+		assert.Equal(t, testCase.expectedTexts, texts)
+		assert.True(t, errors.Is(lineErr, testCase.expectedError))
+	}
+}
+
+func TestWaitForLogLine(t *testing.T) {
+	testCases := []struct {
+		pattern            string
+		expectedLine       string
+		expectedSubmatches []string
+		expectedError      bool
+	}{
+		{
+			pattern:            "fake (l.*)",
+			expectedLine:       "fake logs",
+			expectedSubmatches: []string{"logs"},
+		},
+		{
+			pattern:       "reconciled",
+			expectedError: true,
+		},
+		{
+			pattern:       "(",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image")
+
+		line, submatches, err := testBuilder.WaitForLogLine(testCase.pattern, "test", time.Second)
+
+		if testCase.expectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedLine, line)
+			assert.Equal(t, testCase.expectedSubmatches, submatches)
+		}
+	}
+}
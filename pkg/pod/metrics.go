@@ -0,0 +1,234 @@
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metricsv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metrics/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UsageStats holds the minimum, average and maximum usage of a single resource observed while sampling.
+type UsageStats struct {
+	Min resource.Quantity
+	Avg resource.Quantity
+	Max resource.Quantity
+}
+
+// ContainerUsageStats holds the usage statistics of a single container collected by SampleMetrics.
+type ContainerUsageStats struct {
+	// Samples is the number of metrics samples that included the container.
+	Samples int
+	// Usage holds the statistics per resource, usually cpu and memory.
+	Usage map[corev1.ResourceName]UsageStats
+}
+
+// GetMetrics returns the latest resource usage of the pod as reported by the metrics.k8s.io API.
+func (builder *Builder) GetMetrics() (*metricsv1beta1.PodMetrics, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting metrics of pod %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.apiClient.AttachScheme(metricsv1beta1.AddToScheme); err != nil {
+		glog.V(100).Infof("Failed to add metrics v1beta1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	podMetrics := &metricsv1beta1.PodMetrics{}
+
+	err := builder.apiClient.Client.Get(context.TODO(), runtimeclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, podMetrics)
+	if err != nil {
+		glog.V(100).Infof("Failed to get metrics of pod %s in namespace %s due to %s",
+			builder.Definition.Name, builder.Definition.Namespace, err.Error())
+
+		return nil, err
+	}
+
+	return podMetrics, nil
+}
+
+// GetNamespaceUsage returns the total resource usage of all pods in the given namespace as reported by the
+// metrics.k8s.io API. The optional ListOptions label selector restricts the pods taken into account.
+func GetNamespaceUsage(
+	apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) (corev1.ResourceList, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient cannot be nil")
+
+		return nil, fmt.Errorf("failed to get namespace usage, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("pod metrics 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to get namespace usage, 'nsname' parameter is empty")
+	}
+
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	glog.V(100).Infof("Getting resource usage of pods in namespace %s", nsname)
+
+	listOptions := []runtimeclient.ListOption{runtimeclient.InNamespace(nsname)}
+
+	if len(options) == 1 && options[0].LabelSelector != "" {
+		selector, err := labels.Parse(options[0].LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse label selector %s: %w", options[0].LabelSelector, err)
+		}
+
+		listOptions = append(listOptions, runtimeclient.MatchingLabelsSelector{Selector: selector})
+	}
+
+	if err := apiClient.AttachScheme(metricsv1beta1.AddToScheme); err != nil {
+		glog.V(100).Infof("Failed to add metrics v1beta1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	podMetricsList := &metricsv1beta1.PodMetricsList{}
+
+	err := apiClient.Client.List(context.TODO(), podMetricsList, listOptions...)
+	if err != nil {
+		glog.V(100).Infof("Failed to list pod metrics in namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	totalUsage := corev1.ResourceList{}
+
+	for _, podMetrics := range podMetricsList.Items {
+		for _, container := range podMetrics.Containers {
+			for resourceName, quantity := range container.Usage {
+				total := totalUsage[resourceName]
+				total.Add(quantity)
+				totalUsage[resourceName] = total
+			}
+		}
+	}
+
+	return totalUsage, nil
+}
+
+// SampleMetrics collects the metrics of the pod every interval for the duration of the window and returns the
+// minimum, average and maximum usage per container. Failed samples are skipped and an error is returned only if no
+// sample could be collected.
+func (builder *Builder) SampleMetrics(
+	window, interval time.Duration) (map[string]ContainerUsageStats, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Sampling metrics of pod %s in namespace %s every %s for %s",
+		builder.Definition.Name, builder.Definition.Namespace, interval, window)
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("pod metrics 'interval' must be positive")
+	}
+
+	var samples []*metricsv1beta1.PodMetrics
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), interval, window, true, func(ctx context.Context) (bool, error) {
+			podMetrics, err := builder.GetMetrics()
+			if err != nil {
+				glog.V(100).Infof("Failed to sample metrics of pod %s: %s", builder.Definition.Name, err.Error())
+
+				return false, nil
+			}
+
+			samples = append(samples, podMetrics)
+
+			return false, nil
+		})
+
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return nil, err
+	}
+
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("failed to collect any metrics sample of pod %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return summarizeContainerUsage(samples), nil
+}
+
+// summarizeContainerUsage computes the usage statistics per container and resource from the metrics samples.
+func summarizeContainerUsage(samples []*metricsv1beta1.PodMetrics) map[string]ContainerUsageStats {
+	usageSamples := make(map[string]map[corev1.ResourceName][]resource.Quantity)
+	sampleCounts := make(map[string]int)
+
+	for _, sample := range samples {
+		for _, container := range sample.Containers {
+			if _, ok := usageSamples[container.Name]; !ok {
+				usageSamples[container.Name] = make(map[corev1.ResourceName][]resource.Quantity)
+			}
+
+			sampleCounts[container.Name]++
+
+			for resourceName, quantity := range container.Usage {
+				usageSamples[container.Name][resourceName] = append(
+					usageSamples[container.Name][resourceName], quantity)
+			}
+		}
+	}
+
+	containerStats := make(map[string]ContainerUsageStats)
+
+	for containerName, resourceSamples := range usageSamples {
+		stats := ContainerUsageStats{
+			Samples: sampleCounts[containerName],
+			Usage:   make(map[corev1.ResourceName]UsageStats),
+		}
+
+		for resourceName, quantities := range resourceSamples {
+			stats.Usage[resourceName] = computeUsageStats(quantities)
+		}
+
+		containerStats[containerName] = stats
+	}
+
+	return containerStats
+}
+
+// computeUsageStats returns the minimum, average and maximum of the quantities, which must not be empty.
+func computeUsageStats(quantities []resource.Quantity) UsageStats {
+	stats := UsageStats{Min: quantities[0].DeepCopy(), Max: quantities[0].DeepCopy()}
+
+	var milliSum int64
+
+	for _, quantity := range quantities {
+		if quantity.Cmp(stats.Min) < 0 {
+			stats.Min = quantity.DeepCopy()
+		}
+
+		if quantity.Cmp(stats.Max) > 0 {
+			stats.Max = quantity.DeepCopy()
+		}
+
+		milliSum += quantity.MilliValue()
+	}
+
+	stats.Avg = *resource.NewMilliQuantity(milliSum/int64(len(quantities)), quantities[0].Format)
+
+	return stats
+}
@@ -0,0 +1,229 @@
+package pod
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metricsv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metrics/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultPodContainerName = "test"
+
+func TestPodGetMetrics(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedCPU   string
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPodBuilder(buildPodMetricsClientWithDummyObject()),
+			expectedCPU:   "100m",
+			expectedError: "",
+		},
+		{
+			testBuilder: buildValidPodBuilder(clients.GetTestClients(clients.TestClientParams{
+				SchemeAttachers: []clients.SchemeAttacher{metricsv1beta1.AddToScheme},
+			})),
+			expectedError: "podmetricses.metrics.k8s.io \"test-pod\" not found",
+		},
+		{
+			testBuilder: NewBuilder(
+				buildPodMetricsClientWithDummyObject(), "", defaultPodNamespace, defaultPodImage),
+			expectedError: "pod's name is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		podMetrics, err := testCase.testBuilder.GetMetrics()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedCPU, podMetrics.Containers[0].Usage.Cpu().String())
+	}
+}
+
+func TestPodGetNamespaceUsage(t *testing.T) {
+	testCases := []struct {
+		nsname         string
+		client         bool
+		listOptions    []metav1.ListOptions
+		expectedCPU    string
+		expectedMemory string
+		expectedError  error
+	}{
+		{
+			nsname:         defaultPodNamespace,
+			client:         true,
+			expectedCPU:    "350m",
+			expectedMemory: "128Mi",
+			expectedError:  nil,
+		},
+		{
+			nsname:         defaultPodNamespace,
+			client:         true,
+			listOptions:    []metav1.ListOptions{{LabelSelector: "app=other"}},
+			expectedCPU:    "250m",
+			expectedMemory: "64Mi",
+			expectedError:  nil,
+		},
+		{
+			nsname:        defaultPodNamespace,
+			client:        true,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+		},
+		{
+			nsname:        "",
+			client:        true,
+			expectedError: fmt.Errorf("failed to get namespace usage, 'nsname' parameter is empty"),
+		},
+		{
+			nsname:        defaultPodNamespace,
+			client:        false,
+			expectedError: fmt.Errorf("failed to get namespace usage, 'apiClient' parameter is nil"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			otherPodMetrics := buildDummyPodMetricsWithUsage("other-pod", "250m", "64Mi")
+			otherPodMetrics.Labels = map[string]string{"app": "other"}
+
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  append(buildDummyPodMetrics(), otherPodMetrics),
+				SchemeAttachers: []clients.SchemeAttacher{metricsv1beta1.AddToScheme},
+			})
+		}
+
+		usage, err := GetNamespaceUsage(testSettings, testCase.nsname, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedCPU, usage.Cpu().String())
+			assert.Equal(t, testCase.expectedMemory, usage.Memory().String())
+		}
+	}
+}
+
+func TestPodSampleMetrics(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		interval      time.Duration
+		expectedCPU   string
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidPodBuilder(buildPodMetricsClientWithDummyObject()),
+			interval:      10 * time.Millisecond,
+			expectedCPU:   "100m",
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidPodBuilder(clients.GetTestClients(clients.TestClientParams{
+				SchemeAttachers: []clients.SchemeAttacher{metricsv1beta1.AddToScheme},
+			})),
+			interval: 10 * time.Millisecond,
+			expectedError: fmt.Errorf(
+				"failed to collect any metrics sample of pod test-pod in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildValidPodBuilder(buildPodMetricsClientWithDummyObject()),
+			interval:      0,
+			expectedError: fmt.Errorf("pod metrics 'interval' must be positive"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		stats, err := testCase.testBuilder.SampleMetrics(50*time.Millisecond, testCase.interval)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Greater(t, stats[defaultPodContainerName].Samples, 0)
+
+			cpuStats := stats[defaultPodContainerName].Usage[corev1.ResourceCPU]
+			assert.Equal(t, testCase.expectedCPU, cpuStats.Avg.String())
+		}
+	}
+}
+
+func TestPodSummarizeContainerUsage(t *testing.T) {
+	testCases := []struct {
+		samples         []*metricsv1beta1.PodMetrics
+		expectedSamples int
+		expectedCPU     [3]string
+		expectedMemory  [3]string
+	}{
+		{
+			samples: []*metricsv1beta1.PodMetrics{
+				buildDummyPodMetricsWithUsage(defaultPodName, "100m", "64Mi"),
+			},
+			expectedSamples: 1,
+			expectedCPU:     [3]string{"100m", "100m", "100m"},
+			expectedMemory:  [3]string{"64Mi", "64Mi", "64Mi"},
+		},
+		{
+			samples: []*metricsv1beta1.PodMetrics{
+				buildDummyPodMetricsWithUsage(defaultPodName, "100m", "64Mi"),
+				buildDummyPodMetricsWithUsage(defaultPodName, "300m", "128Mi"),
+				buildDummyPodMetricsWithUsage(defaultPodName, "200m", "96Mi"),
+			},
+			expectedSamples: 3,
+			expectedCPU:     [3]string{"100m", "200m", "300m"},
+			expectedMemory:  [3]string{"64Mi", "96Mi", "128Mi"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		stats := summarizeContainerUsage(testCase.samples)
+		assert.Equal(t, testCase.expectedSamples, stats[defaultPodContainerName].Samples)
+
+		cpuStats := stats[defaultPodContainerName].Usage[corev1.ResourceCPU]
+		assert.Equal(t, testCase.expectedCPU,
+			[3]string{cpuStats.Min.String(), cpuStats.Avg.String(), cpuStats.Max.String()})
+
+		memoryStats := stats[defaultPodContainerName].Usage[corev1.ResourceMemory]
+		assert.Equal(t, testCase.expectedMemory,
+			[3]string{memoryStats.Min.String(), memoryStats.Avg.String(), memoryStats.Max.String()})
+	}
+}
+
+func buildPodMetricsClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyPodMetrics(),
+		SchemeAttachers: []clients.SchemeAttacher{metricsv1beta1.AddToScheme},
+	})
+}
+
+func buildDummyPodMetrics() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyPodMetricsWithUsage(defaultPodName, "100m", "64Mi"))
+}
+
+func buildDummyPodMetricsWithUsage(name, cpu, memory string) *metricsv1beta1.PodMetrics {
+	return &metricsv1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultPodNamespace,
+		},
+		Containers: []metricsv1beta1.ContainerMetrics{{
+			Name: defaultPodContainerName,
+			Usage: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		}},
+	}
+}
@@ -0,0 +1,170 @@
+package pod
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/scheduling"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WithNodeAffinity sets the node affinity of the pod, keeping the other affinity settings.
+func (builder *Builder) WithNodeAffinity(nodeAffinity *corev1.NodeAffinity) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting node affinity %v in pod %s in namespace %s",
+		nodeAffinity, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.SetNodeAffinity(&builder.Definition.Spec, nodeAffinity); err != nil {
+		glog.V(100).Infof("Failed to set node affinity in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithPodAffinity sets the pod affinity of the pod, keeping the other affinity settings.
+func (builder *Builder) WithPodAffinity(podAffinity *corev1.PodAffinity) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting pod affinity %v in pod %s in namespace %s",
+		podAffinity, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.SetPodAffinity(&builder.Definition.Spec, podAffinity); err != nil {
+		glog.V(100).Infof("Failed to set pod affinity in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithPodAntiAffinity sets the pod anti-affinity of the pod, keeping the other affinity settings.
+func (builder *Builder) WithPodAntiAffinity(podAntiAffinity *corev1.PodAntiAffinity) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting pod anti-affinity %v in pod %s in namespace %s",
+		podAntiAffinity, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.SetPodAntiAffinity(&builder.Definition.Spec, podAntiAffinity); err != nil {
+		glog.V(100).Infof("Failed to set pod anti-affinity in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithTopologySpreadConstraint appends a topology spread constraint to the pod.
+func (builder *Builder) WithTopologySpreadConstraint(constraint corev1.TopologySpreadConstraint) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting topology spread constraint %v in pod %s in namespace %s",
+		constraint, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.AddTopologySpreadConstraint(&builder.Definition.Spec, constraint); err != nil {
+		glog.V(100).Infof("Failed to set topology spread constraint in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithPriorityClassName sets the priority class of the pod.
+func (builder *Builder) WithPriorityClassName(priorityClassName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting priority class %s in pod %s in namespace %s",
+		priorityClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.SetPriorityClassName(&builder.Definition.Spec, priorityClassName); err != nil {
+		glog.V(100).Infof("Failed to set priority class in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithRuntimeClassName sets the runtime class of the pod.
+func (builder *Builder) WithRuntimeClassName(runtimeClassName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting runtime class %s in pod %s in namespace %s",
+		runtimeClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.SetRuntimeClassName(&builder.Definition.Spec, runtimeClassName); err != nil {
+		glog.V(100).Infof("Failed to set runtime class in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithDNSConfig sets the DNS policy and DNS config of the pod. The None policy requires a config with at least one
+// nameserver.
+func (builder *Builder) WithDNSConfig(dnsPolicy corev1.DNSPolicy, dnsConfig *corev1.PodDNSConfig) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting DNS policy %s with config %v in pod %s in namespace %s",
+		dnsPolicy, dnsConfig, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.SetDNSConfig(&builder.Definition.Spec, dnsPolicy, dnsConfig); err != nil {
+		glog.V(100).Infof("Failed to set DNS policy in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithHostAlias appends an /etc/hosts entry to the pod.
+func (builder *Builder) WithHostAlias(hostAlias corev1.HostAlias) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting host alias %v in pod %s in namespace %s",
+		hostAlias, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := scheduling.AddHostAlias(&builder.Definition.Spec, hostAlias); err != nil {
+		glog.V(100).Infof("Failed to set host alias in pod %s: %s", builder.Definition.Name, err.Error())
+
+		builder.errorMsg = fmt.Sprintf("pod %s", err.Error())
+
+		return builder
+	}
+
+	return builder
+}
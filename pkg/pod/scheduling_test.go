@@ -0,0 +1,38 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodWithSchedulingOptions(t *testing.T) {
+	testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image").
+		WithPodAntiAffinity(&corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+				TopologyKey:   corev1.LabelHostname,
+			}},
+		}).
+		WithTopologySpreadConstraint(corev1.TopologySpreadConstraint{
+			MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway,
+		}).
+		WithPriorityClassName("system-cluster-critical").
+		WithRuntimeClassName("performance-test").
+		WithDNSConfig(corev1.DNSNone, &corev1.PodDNSConfig{Nameservers: []string{"10.0.0.10"}}).
+		WithHostAlias(corev1.HostAlias{IP: "10.0.0.1", Hostnames: []string{"registry.local"}})
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.NotNil(t, testBuilder.Definition.Spec.Affinity.PodAntiAffinity)
+	assert.Len(t, testBuilder.Definition.Spec.TopologySpreadConstraints, 1)
+	assert.Equal(t, "system-cluster-critical", testBuilder.Definition.Spec.PriorityClassName)
+	assert.Equal(t, "performance-test", *testBuilder.Definition.Spec.RuntimeClassName)
+	assert.Equal(t, corev1.DNSNone, testBuilder.Definition.Spec.DNSPolicy)
+	assert.Len(t, testBuilder.Definition.Spec.HostAliases, 1)
+
+	testBuilder = NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image").
+		WithNodeAffinity(nil)
+	assert.Equal(t, "pod 'nodeAffinity' cannot be empty", testBuilder.errorMsg)
+}
@@ -0,0 +1,406 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// UploadFile copies a local file into the given path of the specified container. The file mode of the local file
+// is preserved. When verifyChecksum is set, the sha256 checksum of the uploaded file is compared with the local one.
+func (builder *Builder) UploadFile(localPath, remotePath, containerName string, verifyChecksum bool) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Uploading local file %s to %s in container %s of pod %s in namespace %s",
+		localPath, remotePath, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if localPath == "" {
+		glog.V(100).Infof("The localPath of the file to upload is empty")
+
+		return fmt.Errorf("cannot upload file with empty localPath")
+	}
+
+	fileInfo, err := os.Stat(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to stat local file %s: %w", localPath, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("local path %s is not a regular file", localPath)
+	}
+
+	content, err := os.ReadFile(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to read local file %s: %w", localPath, err)
+	}
+
+	return builder.UploadBytes(content, remotePath, containerName, fileInfo.Mode().Perm(), verifyChecksum)
+}
+
+// UploadBytes writes the given content into a file at remotePath in the specified container using the provided
+// file mode. When verifyChecksum is set, the sha256 checksum of the uploaded file is compared with the local one.
+func (builder *Builder) UploadBytes(
+	content []byte, remotePath, containerName string, mode os.FileMode, verifyChecksum bool) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Uploading %d bytes to %s in container %s of pod %s in namespace %s",
+		len(content), remotePath, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateUploadTarget(remotePath, containerName); err != nil {
+		return err
+	}
+
+	var archive bytes.Buffer
+
+	tarWriter := tar.NewWriter(&archive)
+
+	err := tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path.Base(remotePath),
+		Mode:     int64(mode.Perm()),
+		Size:     int64(len(content)),
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write tar header for %s: %w", remotePath, err)
+	}
+
+	if _, err = tarWriter.Write(content); err != nil {
+		return fmt.Errorf("failed to write tar content for %s: %w", remotePath, err)
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close tar archive for %s: %w", remotePath, err)
+	}
+
+	err = builder.UploadTar(&archive, path.Dir(remotePath), containerName)
+	if err != nil {
+		return err
+	}
+
+	if !verifyChecksum {
+		return nil
+	}
+
+	return builder.verifyRemoteChecksum(remotePath, containerName, sha256Sum(content))
+}
+
+// UploadDirectory recursively copies a local directory into remotePath of the specified container. File modes are
+// preserved. When verifyChecksum is set, the sha256 checksum of every uploaded regular file is compared with the
+// local one.
+func (builder *Builder) UploadDirectory(localPath, remotePath, containerName string, verifyChecksum bool) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Uploading local directory %s to %s in container %s of pod %s in namespace %s",
+		localPath, remotePath, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if localPath == "" {
+		glog.V(100).Infof("The localPath of the directory to upload is empty")
+
+		return fmt.Errorf("cannot upload directory with empty localPath")
+	}
+
+	if err := validateUploadTarget(remotePath, containerName); err != nil {
+		return err
+	}
+
+	var archive bytes.Buffer
+
+	checksums, err := createTarFromDirectory(localPath, path.Base(remotePath), &archive)
+	if err != nil {
+		return err
+	}
+
+	err = builder.UploadTar(&archive, path.Dir(remotePath), containerName)
+	if err != nil {
+		return err
+	}
+
+	if !verifyChecksum {
+		return nil
+	}
+
+	for relativePath, checksum := range checksums {
+		err = builder.verifyRemoteChecksum(path.Join(path.Dir(remotePath), relativePath), containerName, checksum)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UploadTar extracts the given tar stream into remoteDir of the specified container. The directory is created if it
+// does not exist and file modes stored in the archive are preserved.
+func (builder *Builder) UploadTar(tarStream io.Reader, remoteDir, containerName string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Extracting tar stream into %s in container %s of pod %s in namespace %s",
+		remoteDir, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if tarStream == nil {
+		glog.V(100).Infof("The tar stream to upload is nil")
+
+		return fmt.Errorf("cannot upload nil tar stream")
+	}
+
+	if err := validateUploadTarget(remoteDir, containerName); err != nil {
+		return err
+	}
+
+	if builder.Object == nil {
+		glog.V(100).Infof("Pod %s in namespace %s does not exist", builder.Definition.Name, builder.Definition.Namespace)
+
+		return fmt.Errorf("cannot upload to pod %s which does not exist", builder.Definition.Name)
+	}
+
+	var stderr bytes.Buffer
+
+	err := builder.execWithStreams([]string{"mkdir", "-p", remoteDir}, containerName, nil, io.Discard, &stderr)
+	if err != nil {
+		return fmt.Errorf("failed to create directory %s in container %s: %w: %s",
+			remoteDir, containerName, err, stderr.String())
+	}
+
+	stderr.Reset()
+
+	err = builder.execWithStreams(
+		[]string{"tar", "-xpf", "-", "-C", remoteDir}, containerName, tarStream, io.Discard, &stderr)
+	if err != nil {
+		return fmt.Errorf("failed to extract tar stream into %s in container %s: %w: %s",
+			remoteDir, containerName, err, stderr.String())
+	}
+
+	return nil
+}
+
+// verifyRemoteChecksum compares the sha256 checksum of remotePath in the container with the expected one.
+func (builder *Builder) verifyRemoteChecksum(remotePath, containerName, expected string) error {
+	glog.V(100).Infof("Verifying sha256 checksum of %s in container %s", remotePath, containerName)
+
+	var stdout, stderr bytes.Buffer
+
+	err := builder.execWithStreams([]string{"sha256sum", remotePath}, containerName, nil, &stdout, &stderr)
+	if err != nil {
+		return fmt.Errorf("failed to compute checksum of %s in container %s: %w: %s",
+			remotePath, containerName, err, stderr.String())
+	}
+
+	actual, err := parseSha256SumOutput(stdout.String())
+	if err != nil {
+		return err
+	}
+
+	if actual != expected {
+		glog.V(100).Infof("Checksum mismatch for %s: expected %s, got %s", remotePath, expected, actual)
+
+		return fmt.Errorf("checksum mismatch for %s in container %s: expected %s, got %s",
+			remotePath, containerName, expected, actual)
+	}
+
+	return nil
+}
+
+// execWithStreams runs command in the given container without a tty, wiring the provided streams. Ping period is
+// disabled so that large payloads are transferred in their entirety.
+func (builder *Builder) execWithStreams(
+	command []string, containerName string, stdin io.Reader, stdout, stderr io.Writer) error {
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(builder.Object.Namespace).
+		Resource("pods").
+		Name(builder.Object.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+			TTY:       false,
+		}, scheme.ParameterCodec)
+
+	exec, err := builder.newNoPingExecutor(req.URL())
+	if err != nil {
+		return err
+	}
+
+	return exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+		Tty:    false,
+	})
+}
+
+// newNoPingExecutor returns a remotecommand executor with PingPeriod disabled.
+// By default many large files are not copied in their entirety without disabling PingPeriod during the copy.
+// https://github.com/kubernetes/kubernetes/issues/60140#issuecomment-1411477275
+func (builder *Builder) newNoPingExecutor(execURL *url.URL) (remotecommand.Executor, error) {
+	tlsConfig, err := rest.TLSConfigFor(builder.apiClient.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if builder.apiClient.Config.Proxy != nil {
+		proxy = builder.apiClient.Config.Proxy
+	}
+
+	upgradeRoundTripper, err := spdy.NewRoundTripperWithConfig(spdy.RoundTripperConfig{
+		TLS:        tlsConfig,
+		Proxier:    proxy,
+		PingPeriod: 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper, err := rest.HTTPWrappersForConfig(builder.apiClient.Config, upgradeRoundTripper)
+	if err != nil {
+		return nil, err
+	}
+
+	return remotecommand.NewSPDYExecutorForTransports(wrapper, upgradeRoundTripper, "POST", execURL)
+}
+
+// createTarFromDirectory writes the content of localDir into writer as a tar archive with every entry placed under
+// prefix. It returns the sha256 checksums of all regular files keyed by their path inside the archive.
+func createTarFromDirectory(localDir, prefix string, writer io.Writer) (map[string]string, error) {
+	dirInfo, err := os.Stat(localDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat local directory %s: %w", localDir, err)
+	}
+
+	if !dirInfo.IsDir() {
+		return nil, fmt.Errorf("local path %s is not a directory", localDir)
+	}
+
+	checksums := make(map[string]string)
+	tarWriter := tar.NewWriter(writer)
+
+	err = filepath.WalkDir(localDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(localDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+
+		header.Name = path.Join(prefix, filepath.ToSlash(relativePath))
+
+		switch {
+		case info.IsDir():
+			header.Name += "/"
+
+			return tarWriter.WriteHeader(header)
+		case info.Mode().IsRegular():
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+
+			if err = tarWriter.WriteHeader(header); err != nil {
+				return err
+			}
+
+			if _, err = tarWriter.Write(content); err != nil {
+				return err
+			}
+
+			checksums[header.Name] = sha256Sum(content)
+
+			return nil
+		default:
+			glog.V(100).Infof("Skipping non-regular file %s", filePath)
+
+			return nil
+		}
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tar archive from directory %s: %w", localDir, err)
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close tar archive for directory %s: %w", localDir, err)
+	}
+
+	return checksums, nil
+}
+
+func validateUploadTarget(remotePath, containerName string) error {
+	if remotePath == "" {
+		glog.V(100).Infof("The remotePath of the upload is empty")
+
+		return fmt.Errorf("cannot upload to empty remotePath")
+	}
+
+	if !path.IsAbs(remotePath) {
+		glog.V(100).Infof("The remotePath %s of the upload is not absolute", remotePath)
+
+		return fmt.Errorf("remotePath %s must be an absolute path", remotePath)
+	}
+
+	if containerName == "" {
+		glog.V(100).Infof("The containerName of the upload is empty")
+
+		return fmt.Errorf("cannot upload to container with empty name")
+	}
+
+	return nil
+}
+
+func parseSha256SumOutput(output string) (string, error) {
+	fields := strings.Fields(output)
+	if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+		return "", fmt.Errorf("failed to parse sha256sum output: %q", output)
+	}
+
+	return fields[0], nil
+}
+
+func sha256Sum(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,130 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadBytesValidation(t *testing.T) {
+	testCases := []struct {
+		remotePath    string
+		containerName string
+		expectedError error
+	}{
+		{
+			remotePath:    "",
+			containerName: "test",
+			expectedError: fmt.Errorf("cannot upload to empty remotePath"),
+		},
+		{
+			remotePath:    "relative/path",
+			containerName: "test",
+			expectedError: fmt.Errorf("remotePath relative/path must be an absolute path"),
+		},
+		{
+			remotePath:    "/tmp/file",
+			containerName: "",
+			expectedError: fmt.Errorf("cannot upload to container with empty name"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image")
+
+		err := testBuilder.UploadBytes([]byte("test"), testCase.remotePath, testCase.containerName, 0644, true)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestUploadTarPodDoesNotExist(t *testing.T) {
+	testBuilder := NewBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), "test-pod", "test-ns", "test-image")
+
+	err := testBuilder.UploadTar(&bytes.Buffer{}, "/tmp", "test")
+	assert.Equal(t, fmt.Errorf("cannot upload to pod test-pod which does not exist"), err)
+
+	err = testBuilder.UploadTar(nil, "/tmp", "test")
+	assert.Equal(t, fmt.Errorf("cannot upload nil tar stream"), err)
+}
+
+func TestCreateTarFromDirectory(t *testing.T) {
+	localDir := t.TempDir()
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(localDir, "sub"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(localDir, "script.sh"), []byte("#!/bin/sh\n"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(localDir, "sub", "config"), []byte("key=value"), 0600))
+
+	var archive bytes.Buffer
+
+	checksums, err := createTarFromDirectory(localDir, "dest", &archive)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"dest/script.sh":  sha256Sum([]byte("#!/bin/sh\n")),
+		"dest/sub/config": sha256Sum([]byte("key=value")),
+	}, checksums)
+
+	modes := map[string]int64{}
+	tarReader := tar.NewReader(&archive)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.Nil(t, err)
+
+		modes[header.Name] = header.Mode & 0777
+	}
+
+	assert.Equal(t, int64(0755), modes["dest/script.sh"])
+	assert.Equal(t, int64(0600), modes["dest/sub/config"])
+	assert.Contains(t, modes, "dest/sub/")
+
+	_, err = createTarFromDirectory(filepath.Join(localDir, "script.sh"), "dest", &archive)
+	assert.NotNil(t, err)
+}
+
+func TestParseSha256SumOutput(t *testing.T) {
+	validSum := sha256Sum([]byte("test"))
+
+	testCases := []struct {
+		output        string
+		expectedSum   string
+		expectedError bool
+	}{
+		{
+			output:      validSum + "  /tmp/file\n",
+			expectedSum: validSum,
+		},
+		{
+			output:        "",
+			expectedError: true,
+		},
+		{
+			output:        "sha256sum: /tmp/file: No such file or directory",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sum, err := parseSha256SumOutput(testCase.output)
+
+		if testCase.expectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedSum, sum)
+		}
+	}
+}
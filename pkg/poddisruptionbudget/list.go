@@ -0,0 +1,64 @@
+package poddisruptionbudget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns poddisruptionbudget inventory in the given namespace.
+func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("poddisruptionbudget 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("poddisruptionbudget 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets, 'nsname' parameter is empty")
+	}
+
+	logMessage := fmt.Sprintf("Listing poddisruptionbudgets in the namespace %s", nsname)
+	passedOptions := metav1.ListOptions{}
+
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	glog.V(100).Infof(logMessage)
+
+	budgetList, err := apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(nsname).List(
+		context.TODO(), passedOptions)
+	if err != nil {
+		glog.V(100).Infof("Failed to list poddisruptionbudgets in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var budgetObjects []*Builder
+
+	for _, budget := range budgetList.Items {
+		copiedBudget := budget
+		budgetBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedBudget,
+			Definition: &copiedBudget,
+		}
+
+		budgetObjects = append(budgetObjects, budgetBuilder)
+	}
+
+	return budgetObjects, nil
+}
@@ -0,0 +1,446 @@
+package poddisruptionbudget
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Builder provides struct for poddisruptionbudget object containing connection to the cluster and the
+// poddisruptionbudget definitions.
+type Builder struct {
+	// PodDisruptionBudget definition. Used to create a poddisruptionbudget object.
+	Definition *policyv1.PodDisruptionBudget
+	// Created poddisruptionbudget object.
+	Object *policyv1.PodDisruptionBudget
+	// Used in functions that define or mutate poddisruptionbudget definition. errorMsg is processed before the
+	// poddisruptionbudget object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for poddisruptionbudget object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+var retryInterval = time.Second * 3
+
+// NewBuilder creates a new instance of Builder. The selector defines the pods protected by the budget.
+func NewBuilder(apiClient *clients.Settings, name, nsname string, selector map[string]string) *Builder {
+	glog.V(100).Infof(
+		"Initializing new poddisruptionbudget structure with the following params: "+
+			"name: %s, namespace: %s, selector: %v",
+		name, nsname, selector)
+
+	if apiClient == nil {
+		glog.V(100).Infof("poddisruptionbudget 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: selector},
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the poddisruptionbudget is empty")
+
+		builder.errorMsg = "poddisruptionbudget 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the poddisruptionbudget is empty")
+
+		builder.errorMsg = "poddisruptionbudget 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if len(selector) == 0 {
+		glog.V(100).Infof("The selector of the poddisruptionbudget is empty")
+
+		builder.errorMsg = "poddisruptionbudget 'selector' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// Pull loads an existing poddisruptionbudget into the Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing poddisruptionbudget name: %s under namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("poddisruptionbudget 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("poddisruptionbudget 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("poddisruptionbudget 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("poddisruptionbudget object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithMinAvailable sets the number or percentage of selected pods that must remain available after an eviction.
+// It cannot be combined with WithMaxUnavailable.
+func (builder *Builder) WithMinAvailable(minAvailable intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting minAvailable %s in poddisruptionbudget %s in namespace %s",
+		minAvailable.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.MaxUnavailable != nil {
+		builder.errorMsg = "poddisruptionbudget cannot set both 'minAvailable' and 'maxUnavailable'"
+
+		return builder
+	}
+
+	if err := validateBudgetValue(minAvailable); err != nil {
+		builder.errorMsg = fmt.Sprintf("poddisruptionbudget 'minAvailable' is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.MinAvailable = &minAvailable
+
+	return builder
+}
+
+// WithMaxUnavailable sets the number or percentage of selected pods that can be unavailable after an eviction. It
+// cannot be combined with WithMinAvailable.
+func (builder *Builder) WithMaxUnavailable(maxUnavailable intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting maxUnavailable %s in poddisruptionbudget %s in namespace %s",
+		maxUnavailable.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.MinAvailable != nil {
+		builder.errorMsg = "poddisruptionbudget cannot set both 'minAvailable' and 'maxUnavailable'"
+
+		return builder
+	}
+
+	if err := validateBudgetValue(maxUnavailable); err != nil {
+		builder.errorMsg = fmt.Sprintf("poddisruptionbudget 'maxUnavailable' is invalid: %s", err.Error())
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxUnavailable = &maxUnavailable
+
+	return builder
+}
+
+// WithUnhealthyPodEvictionPolicy sets the policy deciding when unhealthy pods may be evicted.
+func (builder *Builder) WithUnhealthyPodEvictionPolicy(
+	policy policyv1.UnhealthyPodEvictionPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting unhealthyPodEvictionPolicy %s in poddisruptionbudget %s in namespace %s",
+		policy, builder.Definition.Name, builder.Definition.Namespace)
+
+	if policy != policyv1.IfHealthyBudget && policy != policyv1.AlwaysAllow {
+		builder.errorMsg = fmt.Sprintf("poddisruptionbudget 'unhealthyPodEvictionPolicy' %s is not supported", policy)
+
+		return builder
+	}
+
+	builder.Definition.Spec.UnhealthyPodEvictionPolicy = &policy
+
+	return builder
+}
+
+// WithOptions creates poddisruptionbudget with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting poddisruptionbudget additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Exists checks whether the given poddisruptionbudget exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if poddisruptionbudget %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+		builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create builds poddisruptionbudget in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+			builder.Definition.Namespace).Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing poddisruptionbudget object with the poddisruptionbudget definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("poddisruptionbudget object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+		builder.Definition.Namespace).Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the poddisruptionbudget.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete poddisruptionbudget: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetDisruptionsAllowed returns the number of pod disruptions currently allowed by the poddisruptionbudget.
+func (builder *Builder) GetDisruptionsAllowed() (int32, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	glog.V(100).Infof("Getting disruptionsAllowed of poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("poddisruptionbudget object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.DisruptionsAllowed, nil
+}
+
+// IsDisruptionAllowed reports whether the poddisruptionbudget currently allows at least one pod disruption, based
+// on its status and its DisruptionAllowed condition.
+func (builder *Builder) IsDisruptionAllowed() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if poddisruptionbudget %s in namespace %s allows disruptions",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("poddisruptionbudget object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return isDisruptionAllowed(builder.Object), nil
+}
+
+// IsHealthy reports whether the poddisruptionbudget has at least the desired number of healthy pods.
+func (builder *Builder) IsHealthy() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if poddisruptionbudget %s in namespace %s is healthy",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("poddisruptionbudget object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.ObservedGeneration >= builder.Object.Generation &&
+		builder.Object.Status.CurrentHealthy >= builder.Object.Status.DesiredHealthy, nil
+}
+
+// WaitUntilDisruptionsAllowed waits for the duration of the defined timeout or until the poddisruptionbudget
+// allows at least the given number of disruptions. The status is only trusted once the disruption controller
+// observed the latest generation of the budget.
+func (builder *Builder) WaitUntilDisruptionsAllowed(disruptionsAllowed int32, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until poddisruptionbudget %s in namespace %s allows %d "+
+		"disruptions", builder.Definition.Name, builder.Definition.Namespace, disruptionsAllowed)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for poddisruptionbudget %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			return builder.Object.Status.ObservedGeneration >= builder.Object.Generation &&
+				builder.Object.Status.DisruptionsAllowed >= disruptionsAllowed, nil
+		})
+}
+
+// isDisruptionAllowed checks the status counter and, when it is set, the DisruptionAllowed condition.
+func isDisruptionAllowed(budget *policyv1.PodDisruptionBudget) bool {
+	if budget.Status.DisruptionsAllowed < 1 {
+		return false
+	}
+
+	for _, condition := range budget.Status.Conditions {
+		if condition.Type == policyv1.DisruptionAllowedCondition {
+			return condition.Status == metav1.ConditionTrue
+		}
+	}
+
+	return true
+}
+
+func validateBudgetValue(value intstr.IntOrString) error {
+	if value.Type == intstr.String {
+		_, err := intstr.GetScaledValueFromIntOrPercent(&value, 100, true)
+
+		return err
+	}
+
+	if value.IntVal < 0 {
+		return fmt.Errorf("value cannot be negative")
+	}
+
+	return nil
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "PodDisruptionBudget"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,624 @@
+package poddisruptionbudget
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var (
+	defaultBudgetName      = "test-pdb"
+	defaultBudgetNamespace = "test-namespace"
+	defaultBudgetSelector  = map[string]string{"app": "test"}
+)
+
+func TestPodDisruptionBudgetNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		selector      map[string]string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultBudgetName,
+			namespace:     defaultBudgetNamespace,
+			selector:      defaultBudgetSelector,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultBudgetNamespace,
+			selector:      defaultBudgetSelector,
+			client:        true,
+			expectedError: "poddisruptionbudget 'name' cannot be empty",
+		},
+		{
+			name:          defaultBudgetName,
+			namespace:     "",
+			selector:      defaultBudgetSelector,
+			client:        true,
+			expectedError: "poddisruptionbudget 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultBudgetName,
+			namespace:     defaultBudgetNamespace,
+			selector:      nil,
+			client:        true,
+			expectedError: "poddisruptionbudget 'selector' cannot be empty",
+		},
+		{
+			name:          defaultBudgetName,
+			namespace:     defaultBudgetNamespace,
+			selector:      defaultBudgetSelector,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilder(testSettings, testCase.name, testCase.namespace, testCase.selector)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, testCase.selector, testBuilder.Definition.Spec.Selector.MatchLabels)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultBudgetName,
+			namespace:           defaultBudgetNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultBudgetName,
+			namespace:           defaultBudgetNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"poddisruptionbudget object test-pdb does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultBudgetNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("poddisruptionbudget 'name' cannot be empty"),
+		},
+		{
+			name:                defaultBudgetName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("poddisruptionbudget 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultBudgetName,
+			namespace:           defaultBudgetNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("poddisruptionbudget 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPodDisruptionBudget()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetWithMinAvailable(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		minAvailable  intstr.IntOrString
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			minAvailable:  intstr.FromInt32(2),
+			expectedError: "",
+		},
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			minAvailable:  intstr.FromString("50%"),
+			expectedError: "",
+		},
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			minAvailable:  intstr.FromInt32(-1),
+			expectedError: "poddisruptionbudget 'minAvailable' is invalid: value cannot be negative",
+		},
+		{
+			testBuilder: buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()).
+				WithMaxUnavailable(intstr.FromInt32(1)),
+			minAvailable:  intstr.FromInt32(2),
+			expectedError: "poddisruptionbudget cannot set both 'minAvailable' and 'maxUnavailable'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithMinAvailable(testCase.minAvailable)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.minAvailable, *testBuilder.Definition.Spec.MinAvailable)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetWithMaxUnavailable(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		maxUnavailable intstr.IntOrString
+		expectedError  string
+	}{
+		{
+			testBuilder:    buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			maxUnavailable: intstr.FromInt32(1),
+			expectedError:  "",
+		},
+		{
+			testBuilder:    buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			maxUnavailable: intstr.FromString("50%"),
+			expectedError:  "",
+		},
+		{
+			testBuilder:    buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			maxUnavailable: intstr.FromInt32(-1),
+			expectedError:  "poddisruptionbudget 'maxUnavailable' is invalid: value cannot be negative",
+		},
+		{
+			testBuilder: buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()).
+				WithMinAvailable(intstr.FromInt32(2)),
+			maxUnavailable: intstr.FromInt32(1),
+			expectedError:  "poddisruptionbudget cannot set both 'minAvailable' and 'maxUnavailable'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithMaxUnavailable(testCase.maxUnavailable)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.maxUnavailable, *testBuilder.Definition.Spec.MaxUnavailable)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetWithUnhealthyPodEvictionPolicy(t *testing.T) {
+	testCases := []struct {
+		policy        policyv1.UnhealthyPodEvictionPolicyType
+		expectedError string
+	}{
+		{
+			policy:        policyv1.AlwaysAllow,
+			expectedError: "",
+		},
+		{
+			policy:        policyv1.IfHealthyBudget,
+			expectedError: "",
+		},
+		{
+			policy:        "Never",
+			expectedError: "poddisruptionbudget 'unhealthyPodEvictionPolicy' Never is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()).
+			WithUnhealthyPodEvictionPolicy(testCase.policy)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.policy, *testBuilder.Definition.Spec.UnhealthyPodEvictionPolicy)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    AdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				builder.Definition.Spec.MinAvailable = &intstr.IntOrString{IntVal: 1}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, intstr.FromInt32(1), *testBuilder.Definition.Spec.MinAvailable)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestPodDisruptionBudgetCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithMinAvailable(intstr.FromInt32(1)),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedError: fmt.Errorf("poddisruptionbudget 'selector' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf(
+				"poddisruptionbudget object test-pdb does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedError: fmt.Errorf("poddisruptionbudget 'selector' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.WithMaxUnavailable(intstr.FromInt32(1)).Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, intstr.FromInt32(1), *testBuilder.Object.Spec.MaxUnavailable)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			expectedError: fmt.Errorf("poddisruptionbudget 'selector' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestPodDisruptionBudgetIsDisruptionAllowed(t *testing.T) {
+	testCases := []struct {
+		disruptionsAllowed int32
+		condition          metav1.ConditionStatus
+		exists             bool
+		expectedAllowed    bool
+		expectedError      error
+	}{
+		{
+			disruptionsAllowed: 1,
+			exists:             true,
+			expectedAllowed:    true,
+			expectedError:      nil,
+		},
+		{
+			disruptionsAllowed: 0,
+			exists:             true,
+			expectedAllowed:    false,
+			expectedError:      nil,
+		},
+		{
+			disruptionsAllowed: 1,
+			condition:          metav1.ConditionFalse,
+			exists:             true,
+			expectedAllowed:    false,
+			expectedError:      nil,
+		},
+		{
+			disruptionsAllowed: 2,
+			condition:          metav1.ConditionTrue,
+			exists:             true,
+			expectedAllowed:    true,
+			expectedError:      nil,
+		},
+		{
+			exists: false,
+			expectedError: fmt.Errorf(
+				"poddisruptionbudget object test-pdb does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			budget := buildDummyPodDisruptionBudgetWithDisruptions(testCase.disruptionsAllowed)
+
+			if testCase.condition != "" {
+				budget.Status.Conditions = []metav1.Condition{{
+					Type:   policyv1.DisruptionAllowedCondition,
+					Status: testCase.condition,
+				}}
+			}
+
+			runtimeObjects = append(runtimeObjects, budget)
+		}
+
+		testBuilder := buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		allowed, err := testBuilder.IsDisruptionAllowed()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAllowed, allowed)
+
+		disruptionsAllowed, err := testBuilder.GetDisruptionsAllowed()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.disruptionsAllowed, disruptionsAllowed)
+
+		healthy, err := testBuilder.IsHealthy()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.exists, healthy)
+	}
+}
+
+func TestPodDisruptionBudgetWaitUntilDisruptionsAllowed(t *testing.T) {
+	testCases := []struct {
+		testBuilder        *Builder
+		disruptionsAllowed int32
+		expectedError      error
+	}{
+		{
+			testBuilder:        buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			disruptionsAllowed: 1,
+			expectedError:      nil,
+		},
+		{
+			testBuilder:        buildValidPodDisruptionBudgetBuilder(buildPodDisruptionBudgetClientWithDummyObject()),
+			disruptionsAllowed: 2,
+			expectedError:      fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder:        buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			disruptionsAllowed: 1,
+			expectedError: fmt.Errorf(
+				"cannot wait for poddisruptionbudget test-pdb which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitUntilDisruptionsAllowed(testCase.disruptionsAllowed, time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestPodDisruptionBudgetValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil PodDisruptionBudget builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined PodDisruptionBudget",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "PodDisruptionBudget builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodDisruptionBudgetBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidPodDisruptionBudgetBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultBudgetName, defaultBudgetNamespace, defaultBudgetSelector)
+}
+
+func buildInvalidPodDisruptionBudgetBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultBudgetName, defaultBudgetNamespace, nil)
+}
+
+func buildPodDisruptionBudgetClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyPodDisruptionBudget(),
+	})
+}
+
+func buildDummyPodDisruptionBudget() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyPodDisruptionBudgetWithDisruptions(1))
+}
+
+func buildDummyPodDisruptionBudgetWithDisruptions(disruptionsAllowed int32) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       defaultBudgetName,
+			Namespace:  defaultBudgetNamespace,
+			Generation: 1,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: defaultBudgetSelector},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			ObservedGeneration: 1,
+			DisruptionsAllowed: disruptionsAllowed,
+			CurrentHealthy:     2,
+			DesiredHealthy:     2,
+		},
+	}
+}
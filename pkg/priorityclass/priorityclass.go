@@ -0,0 +1,262 @@
+package priorityclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxUserDefinedPriority is the highest value allowed for priority classes not created by the system.
+const maxUserDefinedPriority = int32(1000000000)
+
+// Builder provides struct for priorityclass object containing connection to the cluster and the priorityclass
+// definitions.
+type Builder struct {
+	// PriorityClass definition. Used to create a priorityclass object.
+	Definition *schedulingv1.PriorityClass
+	// Created priorityclass object.
+	Object *schedulingv1.PriorityClass
+	// Used in functions that define or mutate priorityclass definition. errorMsg is processed before the
+	// priorityclass object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for priorityclass object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder. Pods referencing the priorityclass get the given priority value.
+func NewBuilder(apiClient *clients.Settings, name string, value int32) *Builder {
+	glog.V(100).Infof(
+		"Initializing new priorityclass structure with the following params: name: %s, value: %d", name, value)
+
+	if apiClient == nil {
+		glog.V(100).Infof("priorityclass 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Value: value,
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the priorityclass is empty")
+
+		builder.errorMsg = "priorityclass 'name' cannot be empty"
+
+		return builder
+	}
+
+	if value > maxUserDefinedPriority {
+		glog.V(100).Infof("The value of the priorityclass is above the user defined maximum")
+
+		builder.errorMsg = fmt.Sprintf("priorityclass 'value' cannot be greater than %d", maxUserDefinedPriority)
+
+		return builder
+	}
+
+	return builder
+}
+
+// Pull loads an existing priorityclass into the Builder struct.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing priorityclass name: %s", name)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("priorityclass 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("priorityclass 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("priorityclass object %s does not exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithGlobalDefault makes the priorityclass the default for pods without a priorityClassName.
+func (builder *Builder) WithGlobalDefault() *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting priorityclass %s as global default", builder.Definition.Name)
+
+	builder.Definition.GlobalDefault = true
+
+	return builder
+}
+
+// WithPreemptionPolicy sets whether pods of the priorityclass can preempt pods with a lower priority.
+func (builder *Builder) WithPreemptionPolicy(policy corev1.PreemptionPolicy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting preemptionPolicy %s in priorityclass %s", policy, builder.Definition.Name)
+
+	if policy != corev1.PreemptLowerPriority && policy != corev1.PreemptNever {
+		builder.errorMsg = fmt.Sprintf("priorityclass 'preemptionPolicy' %s is not supported", policy)
+
+		return builder
+	}
+
+	builder.Definition.PreemptionPolicy = &policy
+
+	return builder
+}
+
+// WithDescription sets the description of the priorityclass.
+func (builder *Builder) WithDescription(description string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting description %s in priorityclass %s", description, builder.Definition.Name)
+
+	builder.Definition.Description = description
+
+	return builder
+}
+
+// WithOptions creates priorityclass with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting priorityclass additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Exists checks whether the given priorityclass exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if priorityclass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create builds priorityclass in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating priorityclass %s", builder.Definition.Name)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Delete removes the priorityclass.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting priorityclass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete priorityclass: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "PriorityClass"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,408 @@
+package priorityclass
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultPriorityClassName  = "test-priority"
+	defaultPriorityClassValue = int32(1000)
+)
+
+func TestPriorityClassNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         int32
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultPriorityClassName,
+			value:         defaultPriorityClassValue,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			value:         defaultPriorityClassValue,
+			client:        true,
+			expectedError: "priorityclass 'name' cannot be empty",
+		},
+		{
+			name:          defaultPriorityClassName,
+			value:         2000000000,
+			client:        true,
+			expectedError: "priorityclass 'value' cannot be greater than 1000000000",
+		},
+		{
+			name:          defaultPriorityClassName,
+			value:         defaultPriorityClassValue,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilder(testSettings, testCase.name, testCase.value)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.value, testBuilder.Definition.Value)
+		}
+	}
+}
+
+func TestPriorityClassPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultPriorityClassName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultPriorityClassName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("priorityclass object test-priority does not exist"),
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("priorityclass 'name' cannot be empty"),
+		},
+		{
+			name:                defaultPriorityClassName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("priorityclass 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPriorityClass()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, defaultPriorityClassValue, testBuilder.Object.Value)
+		}
+	}
+}
+
+func TestPriorityClassWithGlobalDefault(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedError: "",
+		},
+		{
+			testBuilder:   buildInvalidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedError: "priorityclass 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithGlobalDefault()
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.True(t, testBuilder.Definition.GlobalDefault)
+		}
+	}
+}
+
+func TestPriorityClassWithPreemptionPolicy(t *testing.T) {
+	testCases := []struct {
+		policy        corev1.PreemptionPolicy
+		expectedError string
+	}{
+		{
+			policy:        corev1.PreemptNever,
+			expectedError: "",
+		},
+		{
+			policy:        corev1.PreemptLowerPriority,
+			expectedError: "",
+		},
+		{
+			policy:        "Sometimes",
+			expectedError: "priorityclass 'preemptionPolicy' Sometimes is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()).
+			WithPreemptionPolicy(testCase.policy)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.policy, *testBuilder.Definition.PreemptionPolicy)
+		}
+	}
+}
+
+func TestPriorityClassWithDescription(t *testing.T) {
+	testCases := []struct {
+		description string
+	}{
+		{
+			description: "test description",
+		},
+		{
+			description: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()).
+			WithDescription(testCase.description)
+		assert.Empty(t, testBuilder.errorMsg)
+		assert.Equal(t, testCase.description, testBuilder.Definition.Description)
+	}
+}
+
+func TestPriorityClassWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    AdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				builder.Definition.Description = "test description"
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, "test description", testBuilder.Definition.Description)
+		}
+	}
+}
+
+func TestPriorityClassExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidPriorityClassBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestPriorityClassCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidPriorityClassBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("priorityclass 'name' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Value, testBuilder.Object.Value)
+		}
+	}
+}
+
+func TestPriorityClassDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidPriorityClassBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidPriorityClassBuilder(buildPriorityClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("priorityclass 'name' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestPriorityClassValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil PriorityClass builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined PriorityClass",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "PriorityClass builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPriorityClassBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidPriorityClassBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultPriorityClassName, defaultPriorityClassValue)
+}
+
+func buildInvalidPriorityClassBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, "", defaultPriorityClassValue)
+}
+
+func buildPriorityClassClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyPriorityClass(),
+	})
+}
+
+func buildDummyPriorityClass() []runtime.Object {
+	return append([]runtime.Object{}, &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultPriorityClassName,
+		},
+		Value: defaultPriorityClassValue,
+	})
+}
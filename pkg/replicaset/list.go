@@ -0,0 +1,64 @@
+package replicaset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns replicaset inventory in the given namespace.
+func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("replicaset 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list replicasets, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("replicaset 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list replicasets, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing replicasets in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	glog.V(100).Infof(logMessage)
+
+	replicasetList, err := apiClient.ReplicaSets(nsname).List(context.TODO(), passedOptions)
+
+	if err != nil {
+		glog.V(100).Infof("Failed to list replicasets in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var replicasetObjects []*Builder
+
+	for _, runningReplicaset := range replicasetList.Items {
+		copiedReplicaset := runningReplicaset
+		replicasetBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedReplicaset,
+			Definition: &copiedReplicaset,
+		}
+
+		replicasetObjects = append(replicasetObjects, replicasetBuilder)
+	}
+
+	return replicasetObjects, nil
+}
@@ -7,6 +7,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/stretchr/testify/assert"
@@ -627,3 +629,50 @@ func buildDummyReplicaSet() []runtime.Object {
 		},
 	})
 }
+
+func TestReplicaSetGetPods(t *testing.T) {
+	testReplicaSet := buildDummyReplicaSet()[0].(*appsv1.ReplicaSet)
+	testReplicaSet.UID = "test-uid"
+	testReplicaSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: defaultReplicaSetLabel}
+
+	buildPod := func(name, ownerUID string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: defaultReplicaSetNamespace,
+				Labels:    defaultReplicaSetLabel,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "ReplicaSet",
+					Name:       defaultReplicaSetName,
+					UID:        types.UID(ownerUID),
+					Controller: ptr.To(true),
+				}},
+			},
+		}
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		testReplicaSet, buildPod("test-name-abcde", "test-uid"), buildPod("other-abcde", "other-uid"),
+	}})
+
+	pods, err := buildValidReplicaSetBuilder(testSettings).GetPods()
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "test-name-abcde", pods[0].Object.Name)
+
+	_, err = buildValidReplicaSetBuilder(clients.GetTestClients(clients.TestClientParams{})).GetPods()
+	assert.Equal(t, fmt.Errorf("replicaset object test-name does not exist in namespace test-namespace"), err)
+}
+
+func TestReplicaSetList(t *testing.T) {
+	replicaSets, err := List(buildReplicaSetClientWithDummyObject(), defaultReplicaSetNamespace)
+	assert.Nil(t, err)
+	assert.Len(t, replicaSets, 1)
+
+	_, err = List(buildReplicaSetClientWithDummyObject(), "")
+	assert.Equal(t, fmt.Errorf("failed to list replicasets, 'nsname' parameter is empty"), err)
+
+	_, err = List(nil, defaultReplicaSetNamespace)
+	assert.Equal(t, fmt.Errorf("failed to list replicasets, 'apiClient' parameter is empty"), err)
+}
@@ -0,0 +1,64 @@
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns resourcequota inventory in the given namespace.
+func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("resourcequota 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list resourcequotas, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("resourcequota 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list resourcequotas, 'nsname' parameter is empty")
+	}
+
+	logMessage := fmt.Sprintf("Listing resourcequotas in the namespace %s", nsname)
+	passedOptions := metav1.ListOptions{}
+
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	glog.V(100).Infof(logMessage)
+
+	quotaList, err := apiClient.ResourceQuotas(nsname).List(
+		context.TODO(), passedOptions)
+	if err != nil {
+		glog.V(100).Infof("Failed to list resourcequotas in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var quotaObjects []*Builder
+
+	for _, quota := range quotaList.Items {
+		copiedQuota := quota
+		quotaBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedQuota,
+			Definition: &copiedQuota,
+		}
+
+		quotaObjects = append(quotaObjects, quotaBuilder)
+	}
+
+	return quotaObjects, nil
+}
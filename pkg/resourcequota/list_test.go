@@ -0,0 +1,65 @@
+package resourcequota
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResourceQuotaList(t *testing.T) {
+	testCases := []struct {
+		nsname        string
+		client        bool
+		listOptions   []metav1.ListOptions
+		expectedCount int
+		expectedError error
+	}{
+		{
+			nsname:        defaultQuotaNamespace,
+			client:        true,
+			expectedCount: 1,
+			expectedError: nil,
+		},
+		{
+			nsname:        defaultQuotaNamespace,
+			client:        true,
+			listOptions:   []metav1.ListOptions{{LabelSelector: "test"}},
+			expectedCount: 0,
+			expectedError: nil,
+		},
+		{
+			nsname:        defaultQuotaNamespace,
+			client:        true,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+		},
+		{
+			nsname:        "",
+			client:        true,
+			expectedError: fmt.Errorf("failed to list resourcequotas, 'nsname' parameter is empty"),
+		},
+		{
+			nsname:        defaultQuotaNamespace,
+			client:        false,
+			expectedError: fmt.Errorf("failed to list resourcequotas, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildResourceQuotaClientWithDummyObject()
+		}
+
+		builders, err := List(testSettings, testCase.nsname, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, builders, testCase.expectedCount)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Builder provides struct for resourcequota object containing connection to the cluster and the resourcequota
+// definitions.
+type Builder struct {
+	// ResourceQuota definition. Used to create a resourcequota object.
+	Definition *corev1.ResourceQuota
+	// Created resourcequota object.
+	Object *corev1.ResourceQuota
+	// Used in functions that define or mutate resourcequota definition. errorMsg is processed before the
+	// resourcequota object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for resourcequota object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+var retryInterval = time.Second * 3
+
+// NewBuilder creates a new instance of Builder enforcing the given hard limits in the namespace.
+func NewBuilder(apiClient *clients.Settings, name, nsname string, hard corev1.ResourceList) *Builder {
+	glog.V(100).Infof(
+		"Initializing new resourcequota structure with the following params: name: %s, namespace: %s, hard: %v",
+		name, nsname, hard)
+
+	if apiClient == nil {
+		glog.V(100).Infof("resourcequota 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: hard,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the resourcequota is empty")
+
+		builder.errorMsg = "resourcequota 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the resourcequota is empty")
+
+		builder.errorMsg = "resourcequota 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if len(hard) == 0 {
+		glog.V(100).Infof("The hard limits of the resourcequota are empty")
+
+		builder.errorMsg = "resourcequota 'hard' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// Pull loads an existing resourcequota into the Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing resourcequota name: %s under namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("resourcequota 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("resourcequota 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("resourcequota 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("resourcequota object %s does not exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithScopes restricts the resourcequota to the objects matching all the given scopes, for example Terminating or
+// BestEffort.
+func (builder *Builder) WithScopes(scopes ...corev1.ResourceQuotaScope) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting scopes %v in resourcequota %s in namespace %s",
+		scopes, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(scopes) == 0 {
+		builder.errorMsg = "resourcequota 'scopes' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Scopes = scopes
+
+	return builder
+}
+
+// WithPriorityClassScope restricts the resourcequota to pods using one of the given priority classes.
+func (builder *Builder) WithPriorityClassScope(priorityClassNames ...string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting priority class scope %v in resourcequota %s in namespace %s",
+		priorityClassNames, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(priorityClassNames) == 0 {
+		builder.errorMsg = "resourcequota 'priorityClassNames' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ScopeSelector = &corev1.ScopeSelector{
+		MatchExpressions: []corev1.ScopedResourceSelectorRequirement{{
+			ScopeName: corev1.ResourceQuotaScopePriorityClass,
+			Operator:  corev1.ScopeSelectorOpIn,
+			Values:    priorityClassNames,
+		}},
+	}
+
+	return builder
+}
+
+// WithOptions creates resourcequota with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting resourcequota additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Exists checks whether the given resourcequota exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if resourcequota %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create builds resourcequota in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing resourcequota object with the resourcequota definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("resourcequota object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the resourcequota.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete resourcequota: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetUsage returns the current usage and the hard limits enforced by the resourcequota.
+func (builder *Builder) GetUsage() (used, hard corev1.ResourceList, err error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, nil, err
+	}
+
+	glog.V(100).Infof("Getting usage of resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, nil, fmt.Errorf("resourcequota object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Used, builder.Object.Status.Hard, nil
+}
+
+// GetRemaining returns, for each resource with a hard limit, how much can still be consumed before the
+// resourcequota rejects new objects. Exceeded limits are reported as zero.
+func (builder *Builder) GetRemaining() (corev1.ResourceList, error) {
+	used, hard, err := builder.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := corev1.ResourceList{}
+
+	for resourceName, hardQuantity := range hard {
+		remainingQuantity := hardQuantity.DeepCopy()
+
+		if usedQuantity, ok := used[resourceName]; ok {
+			remainingQuantity.Sub(usedQuantity)
+		}
+
+		if remainingQuantity.Sign() < 0 {
+			remainingQuantity = *resource.NewQuantity(0, hardQuantity.Format)
+		}
+
+		remaining[resourceName] = remainingQuantity
+	}
+
+	return remaining, nil
+}
+
+// IsExhausted reports whether the usage of the given resource reached its hard limit.
+func (builder *Builder) IsExhausted(resourceName corev1.ResourceName) (bool, error) {
+	used, hard, err := builder.GetUsage()
+	if err != nil {
+		return false, err
+	}
+
+	hardQuantity, ok := hard[resourceName]
+	if !ok {
+		return false, fmt.Errorf("resourcequota %s has no hard limit for resource %s",
+			builder.Definition.Name, resourceName)
+	}
+
+	usedQuantity := used[resourceName]
+
+	return usedQuantity.Cmp(hardQuantity) >= 0, nil
+}
+
+// WaitUntilUsed waits for the duration of the defined timeout or until the usage of the given resource recorded by
+// the quota controller equals the expected quantity.
+func (builder *Builder) WaitUntilUsed(
+	resourceName corev1.ResourceName, expected resource.Quantity, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until resourcequota %s in namespace %s uses %s of %s",
+		builder.Definition.Name, builder.Definition.Namespace, expected.String(), resourceName)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for resourcequota %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			usedQuantity, ok := builder.Object.Status.Used[resourceName]
+
+			return ok && usedQuantity.Cmp(expected) == 0, nil
+		})
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "ResourceQuota"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,586 @@
+package resourcequota
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultQuotaName      = "test-quota"
+	defaultQuotaNamespace = "test-namespace"
+	defaultQuotaHard      = corev1.ResourceList{
+		corev1.ResourcePods: resource.MustParse("4"),
+		corev1.ResourceCPU:  resource.MustParse("2"),
+	}
+)
+
+func TestResourceQuotaNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		hard          corev1.ResourceList
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultQuotaName,
+			namespace:     defaultQuotaNamespace,
+			hard:          defaultQuotaHard,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultQuotaNamespace,
+			hard:          defaultQuotaHard,
+			client:        true,
+			expectedError: "resourcequota 'name' cannot be empty",
+		},
+		{
+			name:          defaultQuotaName,
+			namespace:     "",
+			hard:          defaultQuotaHard,
+			client:        true,
+			expectedError: "resourcequota 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultQuotaName,
+			namespace:     defaultQuotaNamespace,
+			hard:          nil,
+			client:        true,
+			expectedError: "resourcequota 'hard' cannot be empty",
+		},
+		{
+			name:          defaultQuotaName,
+			namespace:     defaultQuotaNamespace,
+			hard:          defaultQuotaHard,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilder(testSettings, testCase.name, testCase.namespace, testCase.hard)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, testCase.hard, testBuilder.Definition.Spec.Hard)
+		}
+	}
+}
+
+func TestResourceQuotaPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultQuotaName,
+			namespace:           defaultQuotaNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultQuotaName,
+			namespace:           defaultQuotaNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"resourcequota object test-quota does not exist in namespace test-namespace"),
+		},
+		{
+			name:                "",
+			namespace:           defaultQuotaNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("resourcequota 'name' cannot be empty"),
+		},
+		{
+			name:                defaultQuotaName,
+			namespace:           "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("resourcequota 'nsname' cannot be empty"),
+		},
+		{
+			name:                defaultQuotaName,
+			namespace:           defaultQuotaNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("resourcequota 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyResourceQuota()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestResourceQuotaWithScopes(t *testing.T) {
+	testCases := []struct {
+		scopes        []corev1.ResourceQuotaScope
+		expectedError string
+	}{
+		{
+			scopes:        []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort},
+			expectedError: "",
+		},
+		{
+			scopes:        []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeTerminating},
+			expectedError: "",
+		},
+		{
+			scopes:        nil,
+			expectedError: "resourcequota 'scopes' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()).
+			WithScopes(testCase.scopes...)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.scopes, testBuilder.Definition.Spec.Scopes)
+		}
+	}
+}
+
+func TestResourceQuotaWithPriorityClassScope(t *testing.T) {
+	testCases := []struct {
+		priorityClassNames []string
+		expectedError      string
+	}{
+		{
+			priorityClassNames: []string{"high"},
+			expectedError:      "",
+		},
+		{
+			priorityClassNames: []string{"high", "medium"},
+			expectedError:      "",
+		},
+		{
+			priorityClassNames: nil,
+			expectedError:      "resourcequota 'priorityClassNames' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()).
+			WithPriorityClassScope(testCase.priorityClassNames...)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, corev1.ResourceQuotaScopePriorityClass,
+				testBuilder.Definition.Spec.ScopeSelector.MatchExpressions[0].ScopeName)
+			assert.Equal(t, testCase.priorityClassNames,
+				testBuilder.Definition.Spec.ScopeSelector.MatchExpressions[0].Values)
+		}
+	}
+}
+
+func TestResourceQuotaWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    AdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				builder.Definition.Spec.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort}
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort},
+				testBuilder.Definition.Spec.Scopes)
+		}
+	}
+}
+
+func TestResourceQuotaExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidResourceQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestResourceQuotaCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedError: fmt.Errorf("resourcequota 'hard' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestResourceQuotaUpdate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidResourceQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf(
+				"resourcequota object test-quota does not exist in namespace test-namespace"),
+		},
+		{
+			testBuilder:   buildInvalidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedError: fmt.Errorf("resourcequota 'hard' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.testBuilder.Definition.Spec.Hard != nil {
+			testCase.testBuilder.Definition.Spec.Hard[corev1.ResourcePods] = resource.MustParse("8")
+		}
+
+		testBuilder, err := testCase.testBuilder.Update()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			podsQuantity := testBuilder.Object.Spec.Hard[corev1.ResourcePods]
+			assert.Equal(t, int64(8), podsQuantity.Value())
+		}
+	}
+}
+
+func TestResourceQuotaDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expectedError: fmt.Errorf("resourcequota 'hard' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestResourceQuotaGetUsage(t *testing.T) {
+	testCases := []struct {
+		usedPods          string
+		usedCPU           string
+		client            *clients.Settings
+		expectedPods      int64
+		expectedCPU       int64
+		expectedExhausted bool
+		expectedError     error
+	}{
+		{
+			usedPods:     "1",
+			usedCPU:      "500m",
+			expectedPods: 3,
+			expectedCPU:  1500,
+		},
+		{
+			usedPods:          "4",
+			usedCPU:           "2",
+			expectedPods:      0,
+			expectedCPU:       0,
+			expectedExhausted: true,
+		},
+		{
+			usedPods:          "5",
+			usedCPU:           "1",
+			expectedPods:      0,
+			expectedCPU:       1000,
+			expectedExhausted: true,
+		},
+		{
+			client: clients.GetTestClients(clients.TestClientParams{}),
+			expectedError: fmt.Errorf(
+				"resourcequota object test-quota does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := testCase.client
+		if testSettings == nil {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{buildDummyResourceQuotaWithUsage(testCase.usedPods, testCase.usedCPU)},
+			})
+		}
+
+		testBuilder := buildValidResourceQuotaBuilder(testSettings)
+
+		used, hard, err := testBuilder.GetUsage()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			continue
+		}
+
+		assert.Len(t, used, 2)
+		assert.Equal(t, defaultQuotaHard, hard)
+
+		remaining, err := testBuilder.GetRemaining()
+		assert.Nil(t, err)
+
+		remainingPods := remaining[corev1.ResourcePods]
+		remainingCPU := remaining[corev1.ResourceCPU]
+		assert.Equal(t, testCase.expectedPods, remainingPods.Value())
+		assert.Equal(t, testCase.expectedCPU, remainingCPU.MilliValue())
+
+		exhausted, err := testBuilder.IsExhausted(corev1.ResourcePods)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedExhausted, exhausted)
+
+		_, err = testBuilder.IsExhausted(corev1.ResourceMemory)
+		assert.Equal(t, fmt.Errorf("resourcequota test-quota has no hard limit for resource memory"), err)
+	}
+}
+
+func TestResourceQuotaWaitUntilUsed(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expected      resource.Quantity
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expected:      resource.MustParse("1"),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidResourceQuotaBuilder(buildResourceQuotaClientWithDummyObject()),
+			expected:      resource.MustParse("2"),
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder: buildValidResourceQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expected:    resource.MustParse("1"),
+			expectedError: fmt.Errorf(
+				"cannot wait for resourcequota test-quota which does not exist in namespace test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitUntilUsed(corev1.ResourcePods, testCase.expected, time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestResourceQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil ResourceQuota builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined ResourceQuota",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "ResourceQuota builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidResourceQuotaBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidResourceQuotaBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultQuotaName, defaultQuotaNamespace, defaultQuotaHard.DeepCopy())
+}
+
+func buildInvalidResourceQuotaBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultQuotaName, defaultQuotaNamespace, nil)
+}
+
+func buildResourceQuotaClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyResourceQuota(),
+	})
+}
+
+func buildDummyResourceQuota() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyResourceQuotaWithUsage("1", "500m"))
+}
+
+func buildDummyResourceQuotaWithUsage(usedPods, usedCPU string) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultQuotaName,
+			Namespace: defaultQuotaNamespace,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: defaultQuotaHard.DeepCopy(),
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: defaultQuotaHard.DeepCopy(),
+			Used: corev1.ResourceList{
+				corev1.ResourcePods: resource.MustParse(usedPods),
+				corev1.ResourceCPU:  resource.MustParse(usedCPU),
+			},
+		},
+	}
+}
@@ -3,19 +3,31 @@ package route
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/strings/slices"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	maxBackendWeight     = 256
+	maxAlternateBackends = 3
+)
+
+var retryInterval = time.Second * 3
+
 // Builder provides struct for route object containing connection to the cluster and the route definitions.
 type Builder struct {
 	// Route definition. Used to create a route object
@@ -189,6 +201,193 @@ func (builder *Builder) WithWildCardPolicy(wildcardPolicy string) *Builder {
 	return builder
 }
 
+// WithHostDomain sets the host exposing the route instead of the one generated by the router.
+func (builder *Builder) WithHostDomain(host string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding host %s to route %s in namespace %s",
+		host, builder.Definition.Name, builder.Definition.Namespace)
+
+	if host == "" {
+		glog.V(100).Infof("Received empty route host")
+
+		builder.errorMsg = "route host cannot be empty string"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Host = host
+
+	return builder
+}
+
+// WithPath restricts the route to the requests whose path starts with the given path. Paths are not supported by
+// passthrough routes since the router cannot read the encrypted requests.
+func (builder *Builder) WithPath(path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding path %s to route %s in namespace %s",
+		path, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !strings.HasPrefix(path, "/") {
+		glog.V(100).Infof("Received route path which is not absolute")
+
+		builder.errorMsg = fmt.Sprintf("route path %s must start with /", path)
+
+		return builder
+	}
+
+	if builder.isPassthrough() {
+		builder.errorMsg = "route path cannot be set on a passthrough route"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Path = path
+
+	return builder
+}
+
+// WithEdgeTLS terminates TLS at the router. The certificate and key are optional, in which case the default router
+// certificate is used, but must be set together.
+func (builder *Builder) WithEdgeTLS(
+	certificate, key, caCertificate string,
+	insecurePolicy routev1.InsecureEdgeTerminationPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding edge TLS termination with insecure policy %s to route %s in namespace %s",
+		insecurePolicy, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.setTLSConfig(&routev1.TLSConfig{
+		Termination:                   routev1.TLSTerminationEdge,
+		Certificate:                   certificate,
+		Key:                           key,
+		CACertificate:                 caCertificate,
+		InsecureEdgeTerminationPolicy: insecurePolicy,
+	})
+
+	return builder
+}
+
+// WithPassthroughTLS sends the encrypted traffic to the backend, which terminates TLS. The insecure policy can only
+// be None or Redirect.
+func (builder *Builder) WithPassthroughTLS(insecurePolicy routev1.InsecureEdgeTerminationPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding passthrough TLS termination with insecure policy %s to route %s in namespace %s",
+		insecurePolicy, builder.Definition.Name, builder.Definition.Namespace)
+
+	if insecurePolicy == routev1.InsecureEdgeTerminationPolicyAllow {
+		builder.errorMsg = "route insecureEdgeTerminationPolicy Allow is not supported with passthrough termination"
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Path != "" {
+		builder.errorMsg = "route passthrough termination cannot be set on a route with a path"
+
+		return builder
+	}
+
+	builder.setTLSConfig(&routev1.TLSConfig{
+		Termination:                   routev1.TLSTerminationPassthrough,
+		InsecureEdgeTerminationPolicy: insecurePolicy,
+	})
+
+	return builder
+}
+
+// WithReencryptTLS terminates TLS at the router and opens a new TLS connection to the backend, whose certificate is
+// verified with destinationCACertificate.
+func (builder *Builder) WithReencryptTLS(
+	certificate, key, caCertificate, destinationCACertificate string,
+	insecurePolicy routev1.InsecureEdgeTerminationPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding reencrypt TLS termination with insecure policy %s to route %s in namespace %s",
+		insecurePolicy, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.setTLSConfig(&routev1.TLSConfig{
+		Termination:                   routev1.TLSTerminationReencrypt,
+		Certificate:                   certificate,
+		Key:                           key,
+		CACertificate:                 caCertificate,
+		DestinationCACertificate:      destinationCACertificate,
+		InsecureEdgeTerminationPolicy: insecurePolicy,
+	})
+
+	return builder
+}
+
+// WithTargetWeight sets the weight of the service the route points to, relative to its alternate backends.
+func (builder *Builder) WithTargetWeight(weight int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting target weight %d in route %s in namespace %s",
+		weight, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !isValidBackendWeight(weight) {
+		builder.errorMsg = fmt.Sprintf("route target weight %d must be between 0 and %d", weight, maxBackendWeight)
+
+		return builder
+	}
+
+	builder.Definition.Spec.To.Weight = &weight
+
+	return builder
+}
+
+// WithAlternateBackend splits the traffic of the route with another service according to the weights. A route
+// supports up to 3 alternate backends.
+func (builder *Builder) WithAlternateBackend(serviceName string, weight int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding alternate backend %s with weight %d to route %s in namespace %s",
+		serviceName, weight, builder.Definition.Name, builder.Definition.Namespace)
+
+	if serviceName == "" {
+		builder.errorMsg = "route alternate backend serviceName cannot be empty string"
+
+		return builder
+	}
+
+	if !isValidBackendWeight(weight) {
+		builder.errorMsg = fmt.Sprintf("route alternate backend weight %d must be between 0 and %d",
+			weight, maxBackendWeight)
+
+		return builder
+	}
+
+	if len(builder.Definition.Spec.AlternateBackends) >= maxAlternateBackends {
+		builder.errorMsg = fmt.Sprintf("route cannot have more than %d alternate backends", maxAlternateBackends)
+
+		return builder
+	}
+
+	builder.Definition.Spec.AlternateBackends = append(builder.Definition.Spec.AlternateBackends,
+		routev1.RouteTargetReference{
+			Kind:   "Service",
+			Name:   serviceName,
+			Weight: &weight,
+		})
+
+	return builder
+}
+
 // Exists checks whether the given route exists.
 func (builder *Builder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
@@ -273,6 +472,93 @@ func (builder *Builder) Delete() (*Builder, error) {
 	return builder, nil
 }
 
+// IsAdmitted checks whether at least one router admitted the route. An error is returned if every router which
+// reported on the route rejected it.
+func (builder *Builder) IsAdmitted() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if route %s in namespace %s is admitted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("route object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return isRouteAdmitted(builder.Object)
+}
+
+// WaitUntilAdmitted waits for the duration of the defined timeout or until a router admits the route. It returns
+// early with an error if every router which reported on the route rejected it.
+func (builder *Builder) WaitUntilAdmitted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until route %s in namespace %s is admitted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for route %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			route, err := builder.Get()
+			if err != nil {
+				glog.V(100).Infof("Failed to get route %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Object = route
+
+			return isRouteAdmitted(route)
+		})
+}
+
+// GetURL returns the URL exposing the route, using https when TLS is configured. The host is taken from the spec
+// or, when generated by the router, from the first admitting router.
+func (builder *Builder) GetURL() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	glog.V(100).Infof("Getting URL of route %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("route object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	host := builder.Object.Spec.Host
+
+	for _, ingress := range builder.Object.Status.Ingress {
+		if host != "" {
+			break
+		}
+
+		host = ingress.Host
+	}
+
+	if host == "" {
+		return "", fmt.Errorf("route %s in namespace %s has no host", builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	scheme := "http"
+	if builder.Object.Spec.TLS != nil {
+		scheme = "https"
+	}
+
+	routeURL := url.URL{Scheme: scheme, Host: host, Path: builder.Object.Spec.Path}
+
+	return routeURL.String(), nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -305,6 +591,69 @@ func (builder *Builder) validate() (bool, error) {
 	return true, nil
 }
 
+// setTLSConfig validates the TLS configuration before setting it on the route definition.
+func (builder *Builder) setTLSConfig(tlsConfig *routev1.TLSConfig) {
+	if (tlsConfig.Certificate == "") != (tlsConfig.Key == "") {
+		builder.errorMsg = "route TLS certificate and key must be set together"
+
+		return
+	}
+
+	switch tlsConfig.InsecureEdgeTerminationPolicy {
+	case "", routev1.InsecureEdgeTerminationPolicyNone, routev1.InsecureEdgeTerminationPolicyAllow,
+		routev1.InsecureEdgeTerminationPolicyRedirect:
+	default:
+		builder.errorMsg = fmt.Sprintf("route insecureEdgeTerminationPolicy %s is not supported",
+			tlsConfig.InsecureEdgeTerminationPolicy)
+
+		return
+	}
+
+	builder.Definition.Spec.TLS = tlsConfig
+}
+
+func (builder *Builder) isPassthrough() bool {
+	return builder.Definition.Spec.TLS != nil &&
+		builder.Definition.Spec.TLS.Termination == routev1.TLSTerminationPassthrough
+}
+
+// isRouteAdmitted returns true if at least one router admitted the route. An error is returned only if every router
+// which reported the admitted condition rejected the route, since a route may be sharded to routers which reject it.
+func isRouteAdmitted(route *routev1.Route) (bool, error) {
+	reported := 0
+
+	var rejections []string
+
+	for _, ingress := range route.Status.Ingress {
+		for _, condition := range ingress.Conditions {
+			if condition.Type != routev1.RouteAdmitted {
+				continue
+			}
+
+			reported++
+
+			switch condition.Status {
+			case corev1.ConditionTrue:
+				return true, nil
+			case corev1.ConditionFalse:
+				rejections = append(rejections,
+					fmt.Sprintf("%s: %s: %s", ingress.RouterName, condition.Reason, condition.Message))
+			}
+		}
+	}
+
+	if len(rejections) > 0 && len(rejections) == reported {
+		return false, fmt.Errorf("route %s in namespace %s was rejected by router %s",
+			route.Name, route.Namespace, strings.Join(rejections, "; router "))
+	}
+
+	return false, nil
+}
+
+func isValidBackendWeight(weight int32) bool {
+	return weight >= 0 && weight <= maxBackendWeight
+}
+
 func supportedWildCardPolicies() []string {
 	return []string{
 		"Subdomain",
@@ -3,9 +3,11 @@ package route
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -207,3 +209,485 @@ func TestWithWildCardPolicy(t *testing.T) {
 		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
 	}
 }
+
+func TestWithHostDomain(t *testing.T) {
+	testCases := []struct {
+		host           string
+		expectedErrMsg string
+	}{
+		{
+			host:           "app.apps.example.com",
+			expectedErrMsg: "",
+		},
+		{
+			host:           "",
+			expectedErrMsg: "route host cannot be empty string",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithHostDomain(testCase.host)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, testCase.host, testBuilder.Definition.Spec.Host)
+		}
+	}
+}
+
+func TestWithPath(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		path           string
+		expectedErrMsg string
+	}{
+		{
+			testBuilder:    buildValidTestBuilder(),
+			path:           "/api",
+			expectedErrMsg: "",
+		},
+		{
+			testBuilder:    buildValidTestBuilder(),
+			path:           "api",
+			expectedErrMsg: "route path api must start with /",
+		},
+		{
+			testBuilder:    buildValidTestBuilder().WithPassthroughTLS(routev1.InsecureEdgeTerminationPolicyRedirect),
+			path:           "/api",
+			expectedErrMsg: "route path cannot be set on a passthrough route",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithPath(testCase.path)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, testCase.path, testBuilder.Definition.Spec.Path)
+		}
+	}
+}
+
+func TestWithEdgeTLS(t *testing.T) {
+	testCases := []struct {
+		certificate    string
+		key            string
+		insecurePolicy routev1.InsecureEdgeTerminationPolicyType
+		expectedErrMsg string
+	}{
+		{
+			certificate:    "cert",
+			key:            "key",
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			expectedErrMsg: "",
+		},
+		{
+			certificate:    "",
+			key:            "",
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyAllow,
+			expectedErrMsg: "",
+		},
+		{
+			certificate:    "cert",
+			key:            "",
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyNone,
+			expectedErrMsg: "route TLS certificate and key must be set together",
+		},
+		{
+			certificate:    "",
+			key:            "",
+			insecurePolicy: "Deny",
+			expectedErrMsg: "route insecureEdgeTerminationPolicy Deny is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithEdgeTLS(testCase.certificate, testCase.key, "", testCase.insecurePolicy)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, routev1.TLSTerminationEdge, testBuilder.Definition.Spec.TLS.Termination)
+			assert.Equal(t, testCase.certificate, testBuilder.Definition.Spec.TLS.Certificate)
+			assert.Equal(t, testCase.key, testBuilder.Definition.Spec.TLS.Key)
+			assert.Equal(t, testCase.insecurePolicy, testBuilder.Definition.Spec.TLS.InsecureEdgeTerminationPolicy)
+		}
+	}
+}
+
+func TestWithPassthroughTLS(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		insecurePolicy routev1.InsecureEdgeTerminationPolicyType
+		expectedErrMsg string
+	}{
+		{
+			testBuilder:    buildValidTestBuilder(),
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyNone,
+			expectedErrMsg: "",
+		},
+		{
+			testBuilder:    buildValidTestBuilder(),
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			expectedErrMsg: "",
+		},
+		{
+			testBuilder:    buildValidTestBuilder(),
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyAllow,
+			expectedErrMsg: "route insecureEdgeTerminationPolicy Allow is not supported with passthrough termination",
+		},
+		{
+			testBuilder:    buildValidTestBuilder().WithPath("/api"),
+			insecurePolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			expectedErrMsg: "route passthrough termination cannot be set on a route with a path",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithPassthroughTLS(testCase.insecurePolicy)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, routev1.TLSTerminationPassthrough, testBuilder.Definition.Spec.TLS.Termination)
+			assert.Equal(t, testCase.insecurePolicy, testBuilder.Definition.Spec.TLS.InsecureEdgeTerminationPolicy)
+		}
+	}
+}
+
+func TestWithReencryptTLS(t *testing.T) {
+	testCases := []struct {
+		certificate              string
+		key                      string
+		destinationCACertificate string
+		insecurePolicy           routev1.InsecureEdgeTerminationPolicyType
+		expectedErrMsg           string
+	}{
+		{
+			certificate:              "cert",
+			key:                      "key",
+			destinationCACertificate: "destination-ca",
+			insecurePolicy:           routev1.InsecureEdgeTerminationPolicyNone,
+			expectedErrMsg:           "",
+		},
+		{
+			certificate:              "",
+			key:                      "",
+			destinationCACertificate: "destination-ca",
+			insecurePolicy:           routev1.InsecureEdgeTerminationPolicyRedirect,
+			expectedErrMsg:           "",
+		},
+		{
+			certificate:              "",
+			key:                      "key",
+			destinationCACertificate: "destination-ca",
+			insecurePolicy:           routev1.InsecureEdgeTerminationPolicyNone,
+			expectedErrMsg:           "route TLS certificate and key must be set together",
+		},
+		{
+			certificate:              "cert",
+			key:                      "key",
+			destinationCACertificate: "destination-ca",
+			insecurePolicy:           "Deny",
+			expectedErrMsg:           "route insecureEdgeTerminationPolicy Deny is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithReencryptTLS(
+			testCase.certificate, testCase.key, "ca", testCase.destinationCACertificate, testCase.insecurePolicy)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, routev1.TLSTerminationReencrypt, testBuilder.Definition.Spec.TLS.Termination)
+			assert.Equal(t, testCase.destinationCACertificate, testBuilder.Definition.Spec.TLS.DestinationCACertificate)
+			assert.Equal(t, testCase.insecurePolicy, testBuilder.Definition.Spec.TLS.InsecureEdgeTerminationPolicy)
+		}
+	}
+}
+
+func TestWithTargetWeight(t *testing.T) {
+	testCases := []struct {
+		weight         int32
+		expectedErrMsg string
+	}{
+		{
+			weight:         100,
+			expectedErrMsg: "",
+		},
+		{
+			weight:         0,
+			expectedErrMsg: "",
+		},
+		{
+			weight:         256,
+			expectedErrMsg: "",
+		},
+		{
+			weight:         257,
+			expectedErrMsg: "route target weight 257 must be between 0 and 256",
+		},
+		{
+			weight:         -1,
+			expectedErrMsg: "route target weight -1 must be between 0 and 256",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithTargetWeight(testCase.weight)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, testCase.weight, *testBuilder.Definition.Spec.To.Weight)
+		}
+	}
+}
+
+func TestWithAlternateBackend(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		serviceName    string
+		weight         int32
+		expectedErrMsg string
+	}{
+		{
+			testBuilder:    buildValidTestBuilder(),
+			serviceName:    "route-test-service-b",
+			weight:         20,
+			expectedErrMsg: "",
+		},
+		{
+			testBuilder:    buildValidTestBuilder(),
+			serviceName:    "",
+			weight:         20,
+			expectedErrMsg: "route alternate backend serviceName cannot be empty string",
+		},
+		{
+			testBuilder:    buildValidTestBuilder(),
+			serviceName:    "route-test-service-b",
+			weight:         -1,
+			expectedErrMsg: "route alternate backend weight -1 must be between 0 and 256",
+		},
+		{
+			testBuilder:    buildValidTestBuilder(),
+			serviceName:    "route-test-service-b",
+			weight:         257,
+			expectedErrMsg: "route alternate backend weight 257 must be between 0 and 256",
+		},
+		{
+			testBuilder: buildValidTestBuilder().
+				WithAlternateBackend("route-test-service-0", 10).
+				WithAlternateBackend("route-test-service-1", 10).
+				WithAlternateBackend("route-test-service-2", 10),
+			serviceName:    "route-test-service-b",
+			weight:         20,
+			expectedErrMsg: "route cannot have more than 3 alternate backends",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.testBuilder.WithAlternateBackend(testCase.serviceName, testCase.weight)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Len(t, testBuilder.Definition.Spec.AlternateBackends, 1)
+			assert.Equal(t, testCase.serviceName, testBuilder.Definition.Spec.AlternateBackends[0].Name)
+			assert.Equal(t, testCase.weight, *testBuilder.Definition.Spec.AlternateBackends[0].Weight)
+		}
+	}
+}
+
+func TestIsAdmitted(t *testing.T) {
+	testCases := []struct {
+		testBuilder      *Builder
+		expectedAdmitted bool
+		expectedError    error
+	}{
+		{
+			testBuilder:      buildValidTestBuilderWithClient(buildDummyRoute()),
+			expectedAdmitted: true,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{
+				buildDummyRouteWithIngress(buildDummyRouteIngress("default", corev1.ConditionUnknown))}),
+			expectedAdmitted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{
+				buildDummyRouteWithIngress(buildDummyRouteIngress("default", corev1.ConditionFalse))}),
+			expectedAdmitted: false,
+			expectedError: fmt.Errorf(
+				"route route-test-name in namespace route-test-namespace was rejected by router default: " +
+					"HostAlreadyClaimed: host in use"),
+		},
+		{
+			testBuilder:      buildValidTestBuilderWithClient([]runtime.Object{buildDummyRouteWithIngress()}),
+			expectedAdmitted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{buildDummyRouteWithIngress(
+				buildDummyRouteIngress("default", corev1.ConditionFalse),
+				buildDummyRouteIngress("shard", corev1.ConditionTrue))}),
+			expectedAdmitted: true,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{buildDummyRouteWithIngress(
+				buildDummyRouteIngress("default", corev1.ConditionFalse),
+				buildDummyRouteIngress("shard", corev1.ConditionUnknown))}),
+			expectedAdmitted: false,
+			expectedError:    nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{buildDummyRouteWithIngress(
+				buildDummyRouteIngress("default", corev1.ConditionFalse),
+				buildDummyRouteIngress("shard", corev1.ConditionFalse))}),
+			expectedAdmitted: false,
+			expectedError: fmt.Errorf(
+				"route route-test-name in namespace route-test-namespace was rejected by router default: " +
+					"HostAlreadyClaimed: host in use; router shard: HostAlreadyClaimed: host in use"),
+		},
+		{
+			testBuilder:      buildValidTestBuilderWithClient(nil),
+			expectedAdmitted: false,
+			expectedError: fmt.Errorf(
+				"route object route-test-name does not exist in namespace route-test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		admitted, err := testCase.testBuilder.IsAdmitted()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedAdmitted, admitted)
+	}
+}
+
+func TestWaitUntilAdmitted(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidTestBuilderWithClient(buildDummyRoute()),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{
+				buildDummyRouteWithIngress(buildDummyRouteIngress("default", corev1.ConditionUnknown))}),
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{
+				buildDummyRouteWithIngress(buildDummyRouteIngress("default", corev1.ConditionFalse))}),
+			expectedError: fmt.Errorf(
+				"route route-test-name in namespace route-test-namespace was rejected by router default: " +
+					"HostAlreadyClaimed: host in use"),
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient(nil),
+			expectedError: fmt.Errorf(
+				"cannot wait for route route-test-name which does not exist in namespace route-test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitUntilAdmitted(time.Second)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestGetURL(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedURL   string
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{
+				buildDummyRouteWithHost("app.apps.example.com", "", "", nil)}),
+			expectedURL:   "http://app.apps.example.com",
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{buildDummyRouteWithHost(
+				"", "route-test-name-route-test-namespace.apps.example.com", "/api",
+				&routev1.TLSConfig{Termination: routev1.TLSTerminationEdge})}),
+			expectedURL:   "https://route-test-name-route-test-namespace.apps.example.com/api",
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidTestBuilderWithClient([]runtime.Object{
+				buildDummyRouteWithHost("", "", "", nil)}),
+			expectedURL:   "",
+			expectedError: fmt.Errorf("route route-test-name in namespace route-test-namespace has no host"),
+		},
+		{
+			testBuilder:   buildValidTestBuilderWithClient(nil),
+			expectedURL:   "",
+			expectedError: fmt.Errorf("route object route-test-name does not exist in namespace route-test-namespace"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		routeURL, err := testCase.testBuilder.GetURL()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedURL, routeURL)
+	}
+}
+
+func buildValidTestBuilderWithClient(objects []runtime.Object) *Builder {
+	return NewBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects}),
+		"route-test-name", "route-test-namespace", "route-test-service")
+}
+
+func buildDummyRoute() []runtime.Object {
+	return append([]runtime.Object{}, buildDummyRouteWithIngress(buildDummyRouteIngress("default", corev1.ConditionTrue)))
+}
+
+func buildDummyRouteWithIngress(ingresses ...routev1.RouteIngress) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route-test-name",
+			Namespace: "route-test-namespace",
+		},
+		Spec: routev1.RouteSpec{
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: "route-test-service",
+			},
+		},
+		Status: routev1.RouteStatus{
+			Ingress: ingresses,
+		},
+	}
+}
+
+func buildDummyRouteWithHost(host, statusHost, path string, tlsConfig *routev1.TLSConfig) *routev1.Route {
+	ingress := buildDummyRouteIngress("default", corev1.ConditionTrue)
+	ingress.Host = statusHost
+
+	dummyRoute := buildDummyRouteWithIngress(ingress)
+	dummyRoute.Spec.Host = host
+	dummyRoute.Spec.Path = path
+	dummyRoute.Spec.TLS = tlsConfig
+
+	return dummyRoute
+}
+
+func buildDummyRouteIngress(routerName string, admittedStatus corev1.ConditionStatus) routev1.RouteIngress {
+	return routev1.RouteIngress{
+		RouterName: routerName,
+		Conditions: []routev1.RouteIngressCondition{{
+			Type:    routev1.RouteAdmitted,
+			Status:  admittedStatus,
+			Reason:  "HostAlreadyClaimed",
+			Message: "host in use",
+		}},
+	}
+}
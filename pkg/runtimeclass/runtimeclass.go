@@ -0,0 +1,281 @@
+package runtimeclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Builder provides struct for runtimeclass object containing connection to the cluster and the runtimeclass
+// definitions.
+type Builder struct {
+	// RuntimeClass definition. Used to create a runtimeclass object.
+	Definition *nodev1.RuntimeClass
+	// Created runtimeclass object.
+	Object *nodev1.RuntimeClass
+	// Used in functions that define or mutate runtimeclass definition. errorMsg is processed before the
+	// runtimeclass object is created.
+	errorMsg  string
+	apiClient *clients.Settings
+}
+
+// AdditionalOptions additional options for runtimeclass object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder. The handler is the name of the CRI runtime configuration used by
+// the pods of the runtimeclass.
+func NewBuilder(apiClient *clients.Settings, name, handler string) *Builder {
+	glog.V(100).Infof(
+		"Initializing new runtimeclass structure with the following params: name: %s, handler: %s", name, handler)
+
+	if apiClient == nil {
+		glog.V(100).Infof("runtimeclass 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Handler: handler,
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the runtimeclass is empty")
+
+		builder.errorMsg = "runtimeclass 'name' cannot be empty"
+
+		return builder
+	}
+
+	if handler == "" {
+		glog.V(100).Infof("The handler of the runtimeclass is empty")
+
+		builder.errorMsg = "runtimeclass 'handler' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// Pull loads an existing runtimeclass into the Builder struct.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing runtimeclass name: %s", name)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("runtimeclass 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("runtimeclass 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("runtimeclass object %s does not exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithNodeSelector restricts the pods of the runtimeclass to the nodes matching the selector.
+func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting nodeSelector %v in runtimeclass %s", nodeSelector, builder.Definition.Name)
+
+	if len(nodeSelector) == 0 {
+		builder.errorMsg = "runtimeclass 'nodeSelector' cannot be empty"
+
+		return builder
+	}
+
+	builder.scheduling().NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithToleration appends a toleration added to the pods of the runtimeclass.
+func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding toleration %v to runtimeclass %s", toleration, builder.Definition.Name)
+
+	if toleration == (corev1.Toleration{}) {
+		builder.errorMsg = "runtimeclass 'toleration' cannot be empty"
+
+		return builder
+	}
+
+	builder.scheduling().Tolerations = append(builder.scheduling().Tolerations, toleration)
+
+	return builder
+}
+
+// WithPodFixedOverhead sets the resources accounted for each pod of the runtimeclass on top of its containers.
+func (builder *Builder) WithPodFixedOverhead(podFixed corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting podFixed overhead %v in runtimeclass %s", podFixed, builder.Definition.Name)
+
+	if len(podFixed) == 0 {
+		builder.errorMsg = "runtimeclass 'podFixed' overhead cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Overhead = &nodev1.Overhead{PodFixed: podFixed}
+
+	return builder
+}
+
+// WithOptions creates runtimeclass with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting runtimeclass additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = err.Error()
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Exists checks whether the given runtimeclass exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if runtimeclass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create builds runtimeclass in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating runtimeclass %s", builder.Definition.Name)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Delete removes the runtimeclass.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting runtimeclass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete runtimeclass: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// scheduling returns the scheduling settings of the definition, initializing them if needed.
+func (builder *Builder) scheduling() *nodev1.Scheduling {
+	if builder.Definition.Scheduling == nil {
+		builder.Definition.Scheduling = &nodev1.Scheduling{}
+	}
+
+	return builder.Definition.Scheduling
+}
+
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "RuntimeClass"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
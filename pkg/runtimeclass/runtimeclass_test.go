@@ -0,0 +1,412 @@
+package runtimeclass
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultRuntimeClassName = "test-runtimeclass"
+	defaultHandler          = "high-performance"
+)
+
+func TestRuntimeClassNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		handler       string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultRuntimeClassName,
+			handler:       defaultHandler,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			handler:       defaultHandler,
+			client:        true,
+			expectedError: "runtimeclass 'name' cannot be empty",
+		},
+		{
+			name:          defaultRuntimeClassName,
+			handler:       "",
+			client:        true,
+			expectedError: "runtimeclass 'handler' cannot be empty",
+		},
+		{
+			name:          defaultRuntimeClassName,
+			handler:       defaultHandler,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilder(testSettings, testCase.name, testCase.handler)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.handler, testBuilder.Definition.Handler)
+		}
+	}
+}
+
+func TestRuntimeClassPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultRuntimeClassName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultRuntimeClassName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("runtimeclass object test-runtimeclass does not exist"),
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("runtimeclass 'name' cannot be empty"),
+		},
+		{
+			name:                defaultRuntimeClassName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("runtimeclass 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyRuntimeClass()...)
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+			assert.Equal(t, defaultHandler, testBuilder.Object.Handler)
+		}
+	}
+}
+
+func TestRuntimeClassWithNodeSelector(t *testing.T) {
+	testCases := []struct {
+		nodeSelector  map[string]string
+		expectedError string
+	}{
+		{
+			nodeSelector:  map[string]string{"node-role.kubernetes.io/worker-cnf": ""},
+			expectedError: "",
+		},
+		{
+			nodeSelector:  nil,
+			expectedError: "runtimeclass 'nodeSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()).
+			WithNodeSelector(testCase.nodeSelector)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Scheduling.NodeSelector)
+		}
+	}
+}
+
+func TestRuntimeClassWithToleration(t *testing.T) {
+	testCases := []struct {
+		toleration    corev1.Toleration
+		expectedError string
+	}{
+		{
+			toleration:    corev1.Toleration{Key: "test", Operator: corev1.TolerationOpExists},
+			expectedError: "",
+		},
+		{
+			toleration:    corev1.Toleration{},
+			expectedError: "runtimeclass 'toleration' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()).
+			WithToleration(testCase.toleration)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []corev1.Toleration{testCase.toleration}, testBuilder.Definition.Scheduling.Tolerations)
+		}
+	}
+}
+
+func TestRuntimeClassWithPodFixedOverhead(t *testing.T) {
+	testCases := []struct {
+		podFixed      corev1.ResourceList
+		expectedError string
+	}{
+		{
+			podFixed:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			expectedError: "",
+		},
+		{
+			podFixed:      nil,
+			expectedError: "runtimeclass 'podFixed' overhead cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()).
+			WithPodFixedOverhead(testCase.podFixed)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.podFixed, testBuilder.Definition.Overhead.PodFixed)
+		}
+	}
+}
+
+func TestRuntimeClassWithOptions(t *testing.T) {
+	testCases := []struct {
+		testOption    AdditionalOptions
+		expectedError string
+	}{
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				builder.Definition.Handler = "runc"
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			testOption: func(builder *Builder) (*Builder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()).
+			WithOptions(testCase.testOption)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, "runc", testBuilder.Definition.Handler)
+		}
+	}
+}
+
+func TestRuntimeClassExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder    *Builder
+		expectedStatus bool
+	}{
+		{
+			testBuilder:    buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()),
+			expectedStatus: true,
+		},
+		{
+			testBuilder:    buildInvalidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()),
+			expectedStatus: false,
+		},
+		{
+			testBuilder:    buildValidRuntimeClassBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedStatus: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedStatus, testCase.testBuilder.Exists())
+	}
+}
+
+func TestRuntimeClassCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidRuntimeClassBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("runtimeclass 'handler' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Handler, testBuilder.Object.Handler)
+		}
+	}
+}
+
+func TestRuntimeClassDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidRuntimeClassBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildInvalidRuntimeClassBuilder(buildRuntimeClassClientWithDummyObject()),
+			expectedError: fmt.Errorf("runtimeclass 'handler' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func TestRuntimeClassValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		expectedError string
+	}{
+		{
+			builderNil:    true,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "error: received nil RuntimeClass builder",
+		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  false,
+			expectedError: "can not redefine the undefined RuntimeClass",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  true,
+			expectedError: "RuntimeClass builder cannot have nil apiClient",
+		},
+		{
+			builderNil:    false,
+			definitionNil: false,
+			apiClientNil:  false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRuntimeClassBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.apiClient = nil
+		}
+
+		valid, err := testBuilder.validate()
+
+		if testCase.expectedError != "" {
+			assert.False(t, valid)
+			assert.Equal(t, testCase.expectedError, err.Error())
+		} else {
+			assert.True(t, valid)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildValidRuntimeClassBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultRuntimeClassName, defaultHandler)
+}
+
+func buildInvalidRuntimeClassBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultRuntimeClassName, "")
+}
+
+func buildRuntimeClassClientWithDummyObject() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: buildDummyRuntimeClass(),
+	})
+}
+
+func buildDummyRuntimeClass() []runtime.Object {
+	return append([]runtime.Object{}, &nodev1.RuntimeClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultRuntimeClassName,
+		},
+		Handler: defaultHandler,
+	})
+}
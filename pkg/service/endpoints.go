@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var retryInterval = time.Second * 3
+
+// EndpointAddresses holds the ready and not ready backend addresses of a single service port in one address family.
+type EndpointAddresses struct {
+	AddressType discoveryv1.AddressType
+	PortName    string
+	Port        int32
+	Protocol    corev1.Protocol
+	Ready       []string
+	NotReady    []string
+}
+
+// ListEndpointSlices returns the EndpointSlices managed for the service.
+func (builder *Builder) ListEndpointSlices() ([]discoveryv1.EndpointSlice, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Listing EndpointSlices of service %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	sliceList, err := builder.apiClient.K8sClient.DiscoveryV1().EndpointSlices(builder.Definition.Namespace).List(
+		context.TODO(), metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, builder.Definition.Name),
+		})
+	if err != nil {
+		glog.V(100).Infof("Failed to list EndpointSlices of service %s in namespace %s due to %s",
+			builder.Definition.Name, builder.Definition.Namespace, err.Error())
+
+		return nil, err
+	}
+
+	return sliceList.Items, nil
+}
+
+// GetEndpointAddresses returns the ready and not ready addresses of the service grouped by address family and port.
+func (builder *Builder) GetEndpointAddresses() ([]EndpointAddresses, error) {
+	endpointSlices, err := builder.ListEndpointSlices()
+	if err != nil {
+		return nil, err
+	}
+
+	addressesByPort := make(map[string]*EndpointAddresses)
+
+	for _, endpointSlice := range endpointSlices {
+		for _, port := range endpointSlice.Ports {
+			portAddresses := getOrAddEndpointAddresses(addressesByPort, endpointSlice.AddressType, port)
+
+			for _, endpoint := range endpointSlice.Endpoints {
+				if isEndpointReady(endpoint) {
+					portAddresses.Ready = append(portAddresses.Ready, endpoint.Addresses...)
+				} else {
+					portAddresses.NotReady = append(portAddresses.NotReady, endpoint.Addresses...)
+				}
+			}
+		}
+	}
+
+	var endpointAddresses []EndpointAddresses
+
+	for _, portAddresses := range addressesByPort {
+		sort.Strings(portAddresses.Ready)
+		sort.Strings(portAddresses.NotReady)
+
+		endpointAddresses = append(endpointAddresses, *portAddresses)
+	}
+
+	sort.Slice(endpointAddresses, func(i, j int) bool {
+		if endpointAddresses[i].AddressType != endpointAddresses[j].AddressType {
+			return endpointAddresses[i].AddressType < endpointAddresses[j].AddressType
+		}
+
+		if endpointAddresses[i].PortName != endpointAddresses[j].PortName {
+			return endpointAddresses[i].PortName < endpointAddresses[j].PortName
+		}
+
+		return endpointAddresses[i].Port < endpointAddresses[j].Port
+	})
+
+	return endpointAddresses, nil
+}
+
+// GetReadyAddresses returns the unique ready backend addresses of the service in the given address family.
+func (builder *Builder) GetReadyAddresses(addressType discoveryv1.AddressType) ([]string, error) {
+	endpointSlices, err := builder.ListEndpointSlices()
+	if err != nil {
+		return nil, err
+	}
+
+	return collectReadyAddresses(endpointSlices, addressType), nil
+}
+
+// WaitUntilEndpointsReady waits for the duration of the defined timeout or until the service has at least minCount
+// ready backends. Backends are counted per address family, so that a dual-stack backend with both an IPv4 and an IPv6
+// address counts once.
+func (builder *Builder) WaitUntilEndpointsReady(minCount int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until service %s in namespace %s has %d ready endpoints",
+		builder.Definition.Name, builder.Definition.Namespace, minCount)
+
+	if minCount < 1 {
+		return fmt.Errorf("service 'minCount' must be at least 1")
+	}
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for service %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			endpointSlices, err := builder.ListEndpointSlices()
+			if err != nil {
+				return false, nil
+			}
+
+			return countReadyEndpoints(endpointSlices) >= minCount, nil
+		})
+}
+
+// GetLoadBalancerIPs returns the IPs assigned to the LoadBalancer service, as found in status.loadBalancer.ingress.
+func (builder *Builder) GetLoadBalancerIPs() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting LoadBalancer IPs of service %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("service object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil, fmt.Errorf("service %s in namespace %s is of type %s, not LoadBalancer",
+			builder.Definition.Name, builder.Definition.Namespace, builder.Object.Spec.Type)
+	}
+
+	var loadBalancerIPs []string
+
+	for _, ingress := range builder.Object.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			loadBalancerIPs = append(loadBalancerIPs, ingress.IP)
+		}
+	}
+
+	return loadBalancerIPs, nil
+}
+
+// WaitUntilLoadBalancerIPAssigned waits for the duration of the defined timeout or until the LoadBalancer service
+// gets at least one ingress IP, and returns the assigned IPs.
+func (builder *Builder) WaitUntilLoadBalancerIPAssigned(timeout time.Duration) ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until service %s in namespace %s gets a LoadBalancer IP",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cannot wait for service %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var loadBalancerIPs []string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			loadBalancerIPs, err = builder.GetLoadBalancerIPs()
+			if err != nil {
+				return false, err
+			}
+
+			return len(loadBalancerIPs) > 0, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return loadBalancerIPs, nil
+}
+
+// getOrAddEndpointAddresses returns the entry of addressesByPort matching the address family and port, adding an
+// empty one if needed.
+func getOrAddEndpointAddresses(
+	addressesByPort map[string]*EndpointAddresses,
+	addressType discoveryv1.AddressType,
+	port discoveryv1.EndpointPort) *EndpointAddresses {
+	portAddresses := EndpointAddresses{AddressType: addressType}
+
+	if port.Name != nil {
+		portAddresses.PortName = *port.Name
+	}
+
+	if port.Port != nil {
+		portAddresses.Port = *port.Port
+	}
+
+	if port.Protocol != nil {
+		portAddresses.Protocol = *port.Protocol
+	}
+
+	key := fmt.Sprintf("%s/%s/%d/%s",
+		addressType, portAddresses.PortName, portAddresses.Port, portAddresses.Protocol)
+
+	if _, ok := addressesByPort[key]; !ok {
+		addressesByPort[key] = &portAddresses
+	}
+
+	return addressesByPort[key]
+}
+
+// countReadyEndpoints returns the number of unique ready endpoints in the address family which has the most of them.
+// Endpoints are identified by the UID of their target, or by their addresses when they have no target.
+func countReadyEndpoints(endpointSlices []discoveryv1.EndpointSlice) int {
+	readyEndpointsByFamily := make(map[discoveryv1.AddressType]map[string]bool)
+
+	for _, endpointSlice := range endpointSlices {
+		if readyEndpointsByFamily[endpointSlice.AddressType] == nil {
+			readyEndpointsByFamily[endpointSlice.AddressType] = make(map[string]bool)
+		}
+
+		for _, endpoint := range endpointSlice.Endpoints {
+			if !isEndpointReady(endpoint) {
+				continue
+			}
+
+			endpointKey := strings.Join(endpoint.Addresses, ",")
+			if endpoint.TargetRef != nil && endpoint.TargetRef.UID != "" {
+				endpointKey = string(endpoint.TargetRef.UID)
+			}
+
+			readyEndpointsByFamily[endpointSlice.AddressType][endpointKey] = true
+		}
+	}
+
+	readyCount := 0
+
+	for _, readyEndpoints := range readyEndpointsByFamily {
+		readyCount = max(readyCount, len(readyEndpoints))
+	}
+
+	return readyCount
+}
+
+// collectReadyAddresses returns the unique ready addresses of the EndpointSlices, restricted to the address family
+// unless it is empty.
+func collectReadyAddresses(endpointSlices []discoveryv1.EndpointSlice, addressType discoveryv1.AddressType) []string {
+	uniqueAddresses := make(map[string]bool)
+
+	for _, endpointSlice := range endpointSlices {
+		if addressType != "" && endpointSlice.AddressType != addressType {
+			continue
+		}
+
+		for _, endpoint := range endpointSlice.Endpoints {
+			if !isEndpointReady(endpoint) {
+				continue
+			}
+
+			for _, address := range endpoint.Addresses {
+				uniqueAddresses[address] = true
+			}
+		}
+	}
+
+	var readyAddresses []string
+
+	for address := range uniqueAddresses {
+		readyAddresses = append(readyAddresses, address)
+	}
+
+	sort.Strings(readyAddresses)
+
+	return readyAddresses
+}
+
+// isEndpointReady treats a missing ready condition as ready, as documented by the EndpointSlice API.
+func isEndpointReady(endpoint discoveryv1.Endpoint) bool {
+	return endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestServiceGetEndpointAddresses(t *testing.T) {
+	testBuilder := buildValidServiceBuilder(buildServiceClientWithEndpointSlices())
+
+	endpointSlices, err := testBuilder.ListEndpointSlices()
+	assert.Nil(t, err)
+	assert.Len(t, endpointSlices, 3)
+
+	endpointAddresses, err := testBuilder.GetEndpointAddresses()
+	assert.Nil(t, err)
+	assert.Equal(t, []EndpointAddresses{
+		{
+			AddressType: discoveryv1.AddressTypeIPv4,
+			PortName:    "http",
+			Port:        8080,
+			Protocol:    corev1.ProtocolTCP,
+			Ready:       []string{"10.0.0.1", "10.0.0.2"},
+			NotReady:    []string{"10.0.0.3"},
+		},
+		{
+			AddressType: discoveryv1.AddressTypeIPv6,
+			PortName:    "http",
+			Port:        8080,
+			Protocol:    corev1.ProtocolTCP,
+			Ready:       []string{"fd00::1"},
+		},
+	}, endpointAddresses)
+
+	readyAddresses, err := testBuilder.GetReadyAddresses(discoveryv1.AddressTypeIPv4)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, readyAddresses)
+
+	readyAddresses, err = testBuilder.GetReadyAddresses(discoveryv1.AddressTypeIPv6)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"fd00::1"}, readyAddresses)
+
+	_, err = buildInValidServiceBuilder(buildServiceClientWithEndpointSlices()).GetEndpointAddresses()
+	assert.Equal(t, fmt.Errorf("Service 'name' cannot be empty"), err)
+}
+
+func TestServiceWaitUntilEndpointsReady(t *testing.T) {
+	testBuilder := buildValidServiceBuilder(buildServiceClientWithEndpointSlices())
+
+	assert.Nil(t, testBuilder.WaitUntilEndpointsReady(2, time.Second))
+	assert.NotNil(t, testBuilder.WaitUntilEndpointsReady(3, time.Second))
+
+	err := testBuilder.WaitUntilEndpointsReady(0, time.Second)
+	assert.Equal(t, fmt.Errorf("service 'minCount' must be at least 1"), err)
+
+	err = buildValidServiceBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WaitUntilEndpointsReady(1, time.Second)
+	assert.Equal(t, fmt.Errorf("cannot wait for service test-service-name which does not exist in namespace "+
+		"test-service-namespace"), err)
+}
+
+func TestServiceLoadBalancerIPs(t *testing.T) {
+	testCases := []struct {
+		serviceType   corev1.ServiceType
+		ingress       []corev1.LoadBalancerIngress
+		expectedIPs   []string
+		expectedError error
+	}{
+		{
+			serviceType: corev1.ServiceTypeLoadBalancer,
+			ingress:     []corev1.LoadBalancerIngress{{IP: "192.168.10.1"}, {Hostname: "lb.example.com"}},
+			expectedIPs: []string{"192.168.10.1"},
+		},
+		{
+			serviceType:   corev1.ServiceTypeLoadBalancer,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			serviceType: corev1.ServiceTypeClusterIP,
+			expectedError: fmt.Errorf(
+				"service test-service-name in namespace test-service-namespace is of type ClusterIP, not LoadBalancer"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testService := buildDummyService()[0].(*corev1.Service)
+		testService.Spec.Type = testCase.serviceType
+		testService.Status.LoadBalancer.Ingress = testCase.ingress
+
+		testBuilder := buildValidServiceBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{testService}}))
+
+		loadBalancerIPs, err := testBuilder.WaitUntilLoadBalancerIPAssigned(time.Second)
+		if testCase.expectedError != nil {
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedIPs, loadBalancerIPs)
+	}
+}
+
+func TestCountReadyEndpoints(t *testing.T) {
+	buildEndpoint := func(address string, podUID types.UID) discoveryv1.Endpoint {
+		return discoveryv1.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+			TargetRef:  &corev1.ObjectReference{Kind: "Pod", UID: podUID},
+		}
+	}
+
+	testCases := []struct {
+		endpointSlices []discoveryv1.EndpointSlice
+		expectedCount  int
+	}{
+		{
+			endpointSlices: []discoveryv1.EndpointSlice{
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{
+					buildEndpoint("10.0.0.1", "pod-a")}},
+				{AddressType: discoveryv1.AddressTypeIPv6, Endpoints: []discoveryv1.Endpoint{
+					buildEndpoint("fd00::1", "pod-a")}},
+			},
+			expectedCount: 1,
+		},
+		{
+			endpointSlices: []discoveryv1.EndpointSlice{
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{
+					buildEndpoint("10.0.0.1", "pod-a"), buildEndpoint("10.0.0.2", "pod-b")}},
+				{AddressType: discoveryv1.AddressTypeIPv6, Endpoints: []discoveryv1.Endpoint{
+					buildEndpoint("fd00::1", "pod-a")}},
+			},
+			expectedCount: 2,
+		},
+		{
+			endpointSlices: []discoveryv1.EndpointSlice{
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{
+					buildEndpoint("10.0.0.1", "pod-a")}},
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{
+					buildEndpoint("10.0.0.1", "pod-a")}},
+			},
+			expectedCount: 1,
+		},
+		{
+			endpointSlices: nil,
+			expectedCount:  0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedCount, countReadyEndpoints(testCase.endpointSlices))
+	}
+}
+
+func buildServiceClientWithEndpointSlices() *clients.Settings {
+	buildEndpointSlice := func(
+		name string, addressType discoveryv1.AddressType, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: defaultServiceNamespace,
+				Labels:    map[string]string{discoveryv1.LabelServiceName: defaultServiceName},
+			},
+			AddressType: addressType,
+			Endpoints:   endpoints,
+			Ports: []discoveryv1.EndpointPort{{
+				Name:     ptr.To("http"),
+				Port:     ptr.To[int32](8080),
+				Protocol: ptr.To(corev1.ProtocolTCP),
+			}},
+		}
+	}
+
+	runtimeObjects := buildDummyService()
+	runtimeObjects = append(runtimeObjects,
+		buildEndpointSlice("test-service-ipv4-a", discoveryv1.AddressTypeIPv4,
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{
+				Ready: ptr.To(true)}},
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{
+				Ready: ptr.To(false)}}),
+		buildEndpointSlice("test-service-ipv4-b", discoveryv1.AddressTypeIPv4,
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}}),
+		buildEndpointSlice("test-service-ipv6", discoveryv1.AddressTypeIPv6,
+			discoveryv1.Endpoint{Addresses: []string{"fd00::1"}, Conditions: discoveryv1.EndpointConditions{
+				Ready: ptr.To(true)}}),
+		buildEndpointSlice("other-service", discoveryv1.AddressTypeIPv4,
+			discoveryv1.Endpoint{Addresses: []string{"10.0.1.1"}}))
+
+	runtimeObjects[len(runtimeObjects)-1].(*discoveryv1.EndpointSlice).Labels[discoveryv1.LabelServiceName] = "other"
+
+	return clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+}
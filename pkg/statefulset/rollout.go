@@ -0,0 +1,180 @@
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Scale changes the number of replicas of the statefulset and waits for the duration of the defined timeout or
+// until the rollout of the new replica count is complete.
+func (builder *Builder) Scale(replicas int32, timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Scaling statefulset %s in namespace %s to %d replicas",
+		builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if replicas < 0 {
+		return builder, fmt.Errorf("statefulset 'replicas' cannot be negative")
+	}
+
+	builder, err := builder.updateLatest(func(statefulSet *appsv1.StatefulSet) {
+		statefulSet.Spec.Replicas = &replicas
+	})
+	if err != nil {
+		return builder, err
+	}
+
+	return builder, builder.WaitForRolloutComplete(timeout)
+}
+
+// SetPartition changes the rolling update partition of the statefulset in the cluster. Lowering the partition step
+// by step allows staged rollouts of a new pod template.
+func (builder *Builder) SetPartition(partition int32) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Setting rolling update partition of statefulset %s in namespace %s to %d",
+		builder.Definition.Name, builder.Definition.Namespace, partition)
+
+	if partition < 0 {
+		return builder, fmt.Errorf("statefulset 'partition' cannot be negative")
+	}
+
+	return builder.updateLatest(func(statefulSet *appsv1.StatefulSet) {
+		setPartition(statefulSet, partition)
+	})
+}
+
+// WaitForRolloutComplete waits for the duration of the defined timeout or until the latest rollout of the
+// statefulset is complete. With a partitioned rolling update the rollout is complete once every pod with an ordinal
+// at or above the partition is updated, otherwise once the current revision matches the update revision.
+func (builder *Builder) WaitForRolloutComplete(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until rollout of statefulset %s in namespace %s is complete",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for rollout of statefulset %s which does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				glog.V(100).Infof("Failed to get statefulset from cluster. Error is: '%s'", err.Error())
+
+				return false, nil
+			}
+
+			return isRolloutComplete(builder.Object), nil
+		})
+}
+
+// GetOwnedPods returns the pods controlled by the statefulset.
+func (builder *Builder) GetOwnedPods() ([]*pod.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Listing pods owned by statefulset %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("statefulset object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return pod.ListControlledBy(builder.apiClient, builder.Object, builder.Object.Spec.Selector)
+}
+
+// GetPods returns the pods controlled by the statefulset that run the latest revision of its pod template. Pods that
+// are not updated yet, for example because of a rolling update partition, are not included.
+func (builder *Builder) GetPods() ([]*pod.Builder, error) {
+	ownedPods, err := builder.GetOwnedPods()
+	if err != nil {
+		return nil, err
+	}
+
+	updateRevision := builder.Object.Status.UpdateRevision
+	if updateRevision == "" {
+		glog.V(100).Infof("Statefulset %s has no update revision yet", builder.Definition.Name)
+
+		return ownedPods, nil
+	}
+
+	var revisionPods []*pod.Builder
+
+	for _, ownedPod := range ownedPods {
+		if ownedPod.Object.Labels[appsv1.ControllerRevisionHashLabelKey] == updateRevision {
+			revisionPods = append(revisionPods, ownedPod)
+		}
+	}
+
+	return revisionPods, nil
+}
+
+// updateLatest applies the mutation to the latest version of the statefulset in the cluster and updates it.
+func (builder *Builder) updateLatest(mutate func(statefulSet *appsv1.StatefulSet)) (*Builder, error) {
+	if !builder.Exists() {
+		return builder, fmt.Errorf("statefulset object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	mutate(builder.Object)
+
+	builder.Definition = builder.Object
+
+	return builder.Update()
+}
+
+func setPartition(statefulSet *appsv1.StatefulSet, partition int32) {
+	statefulSet.Spec.UpdateStrategy.Type = appsv1.RollingUpdateStatefulSetStrategyType
+
+	if statefulSet.Spec.UpdateStrategy.RollingUpdate == nil {
+		statefulSet.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+	}
+
+	statefulSet.Spec.UpdateStrategy.RollingUpdate.Partition = &partition
+}
+
+// isRolloutComplete reports whether the rollout of the statefulset is complete using the same checks as kubectl
+// rollout status.
+func isRolloutComplete(statefulSet *appsv1.StatefulSet) bool {
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return false
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return false
+	}
+
+	rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType &&
+		rollingUpdate != nil && rollingUpdate.Partition != nil && *rollingUpdate.Partition > 0 {
+		return statefulSet.Status.UpdatedReplicas >= replicas-*rollingUpdate.Partition
+	}
+
+	return statefulSet.Status.UpdateRevision == statefulSet.Status.CurrentRevision
+}
@@ -0,0 +1,135 @@
+package statefulset
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestStatefulSetScale(t *testing.T) {
+	testBuilder := buildValidStatefulSetTestBuilder([]runtime.Object{buildTestStatefulSet(2)})
+
+	testBuilder, err := testBuilder.Scale(2, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), *testBuilder.Object.Spec.Replicas)
+
+	_, err = testBuilder.Scale(-1, time.Second)
+	assert.Equal(t, fmt.Errorf("statefulset 'replicas' cannot be negative"), err)
+
+	_, err = buildValidStatefulSetTestBuilder(nil).Scale(1, time.Second)
+	assert.Equal(t, fmt.Errorf("statefulset object test-statefulset does not exist in namespace test-namespace"), err)
+}
+
+func TestStatefulSetSetPartition(t *testing.T) {
+	testBuilder := buildValidStatefulSetTestBuilder([]runtime.Object{buildTestStatefulSet(3)})
+
+	testBuilder, err := testBuilder.SetPartition(2)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), *testBuilder.Object.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	_, err = testBuilder.SetPartition(-1)
+	assert.Equal(t, fmt.Errorf("statefulset 'partition' cannot be negative"), err)
+}
+
+func TestStatefulSetIsRolloutComplete(t *testing.T) {
+	testCases := []struct {
+		mutate   func(statefulSet *appsv1.StatefulSet)
+		expected bool
+	}{
+		{
+			mutate:   func(statefulSet *appsv1.StatefulSet) {},
+			expected: true,
+		},
+		{
+			mutate:   func(statefulSet *appsv1.StatefulSet) { statefulSet.Generation = 2 },
+			expected: false,
+		},
+		{
+			mutate:   func(statefulSet *appsv1.StatefulSet) { statefulSet.Status.ReadyReplicas = 2 },
+			expected: false,
+		},
+		{
+			mutate:   func(statefulSet *appsv1.StatefulSet) { statefulSet.Status.UpdateRevision = "test-statefulset-2" },
+			expected: false,
+		},
+		{
+			mutate: func(statefulSet *appsv1.StatefulSet) {
+				setPartition(statefulSet, 2)
+				statefulSet.Status.UpdateRevision = "test-statefulset-2"
+				statefulSet.Status.UpdatedReplicas = 1
+			},
+			expected: true,
+		},
+		{
+			mutate: func(statefulSet *appsv1.StatefulSet) {
+				setPartition(statefulSet, 1)
+				statefulSet.Status.UpdateRevision = "test-statefulset-2"
+				statefulSet.Status.UpdatedReplicas = 1
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testStatefulSet := buildTestStatefulSet(3)
+		testCase.mutate(testStatefulSet)
+
+		assert.Equal(t, testCase.expected, isRolloutComplete(testStatefulSet))
+	}
+}
+
+func TestStatefulSetGetOwnedPods(t *testing.T) {
+	testBuilder := buildValidStatefulSetTestBuilder([]runtime.Object{
+		buildTestStatefulSet(1),
+		buildTestStatefulSetPod("test-statefulset-0", "test-uid"),
+		buildTestStatefulSetPod("other-0", "other-uid"),
+	})
+
+	pods, err := testBuilder.GetOwnedPods()
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "test-statefulset-0", pods[0].Object.Name)
+}
+
+func buildTestStatefulSetPod(name, ownerUID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app": "test"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "test-statefulset",
+				UID:        types.UID(ownerUID),
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+}
+
+func TestStatefulSetGetPods(t *testing.T) {
+	testStatefulSet := buildTestStatefulSet(2)
+	testStatefulSet.Status.UpdateRevision = "test-statefulset-2"
+
+	oldPod := buildTestStatefulSetPod("test-statefulset-0", "test-uid")
+	oldPod.Labels[appsv1.ControllerRevisionHashLabelKey] = "test-statefulset-1"
+
+	newPod := buildTestStatefulSetPod("test-statefulset-1", "test-uid")
+	newPod.Labels[appsv1.ControllerRevisionHashLabelKey] = "test-statefulset-2"
+
+	testBuilder := buildValidStatefulSetTestBuilder([]runtime.Object{testStatefulSet, oldPod, newPod})
+
+	pods, err := testBuilder.GetPods()
+	assert.Nil(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "test-statefulset-1", pods[0].Object.Name)
+}
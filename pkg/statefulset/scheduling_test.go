@@ -0,0 +1,36 @@
+package statefulset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStatefulSetWithSchedulingOptions(t *testing.T) {
+	testBuilder := buildValidStatefulSetTestBuilder(nil).
+		WithPodAffinity(&corev1.PodAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: 50,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "cache"}},
+					TopologyKey:   corev1.LabelTopologyZone,
+				},
+			}},
+		}).
+		WithTopologySpreadConstraint(corev1.TopologySpreadConstraint{
+			MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule,
+		}).
+		WithPriorityClassName("high-priority")
+	assert.Empty(t, testBuilder.errorMsg)
+
+	podSpec := testBuilder.Definition.Spec.Template.Spec
+	assert.NotNil(t, podSpec.Affinity.PodAffinity)
+	assert.Len(t, podSpec.TopologySpreadConstraints, 1)
+	assert.Equal(t, "high-priority", podSpec.PriorityClassName)
+
+	testBuilder = buildValidStatefulSetTestBuilder(nil).WithDNSConfig(corev1.DNSNone, nil)
+	assert.Equal(t, "statefulset 'dnsConfig' must have at least one nameserver when 'dnsPolicy' is None",
+		testBuilder.errorMsg)
+}
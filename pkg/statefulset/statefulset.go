@@ -116,6 +116,51 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 	return builder
 }
 
+// WithReplicas sets the desired number of replicas in the statefulset definition.
+func (builder *Builder) WithReplicas(replicas int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting %d replicas in statefulset %s in namespace %s",
+		replicas, builder.Definition.Name, builder.Definition.Namespace)
+
+	if replicas < 0 {
+		glog.V(100).Infof("The replicas of the statefulset cannot be negative")
+
+		builder.errorMsg = "statefulset 'replicas' cannot be negative"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Replicas = &replicas
+
+	return builder
+}
+
+// WithPartition sets the rolling update partition in the statefulset definition. Only pods with an ordinal greater
+// than or equal to the partition are updated when the pod template changes.
+func (builder *Builder) WithPartition(partition int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting rolling update partition %d in statefulset %s in namespace %s",
+		partition, builder.Definition.Name, builder.Definition.Namespace)
+
+	if partition < 0 {
+		glog.V(100).Infof("The partition of the statefulset cannot be negative")
+
+		builder.errorMsg = "statefulset 'partition' cannot be negative"
+
+		return builder
+	}
+
+	setPartition(builder.Definition, partition)
+
+	return builder
+}
+
 // WithOptions creates StatefulSet with generic mutation options.
 func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -189,6 +234,92 @@ func (builder *Builder) Create() (*Builder, error) {
 	return builder, err
 }
 
+// Update renovates the existing statefulset object with the statefulset definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating statefulset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the statefulset.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting statefulset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.StatefulSets(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// DeleteAndWait deletes a statefulset and waits until it is removed from the cluster.
+func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting statefulset %s in namespace %s and waiting for the defined period until it is removed",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.Delete(); err != nil {
+		return err
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.apiClient.StatefulSets(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			return k8serrors.IsNotFound(err), nil
+		})
+}
+
+// CreateAndWaitUntilReady creates a statefulset in the cluster and waits until the statefulset is ready.
+func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating statefulset %s in namespace %s and waiting for the defined period until it is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if _, err := builder.Create(); err != nil {
+		glog.V(100).Infof("Failed to create statefulset. Error is: '%s'", err.Error())
+
+		return nil, err
+	}
+
+	if builder.IsReady(timeout) {
+		return builder, nil
+	}
+
+	return nil, fmt.Errorf("statefulset %s in namespace %s is not ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // Exists checks whether the given statefulset exists.
 func (builder *Builder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
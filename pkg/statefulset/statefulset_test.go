@@ -3,6 +3,7 @@ package statefulset
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/stretchr/testify/assert"
@@ -188,3 +189,88 @@ func TestListInAllNamespaces(t *testing.T) {
 		}
 	}
 }
+
+func TestStatefulSetWithReplicasAndPartition(t *testing.T) {
+	testBuilder := buildValidStatefulSetTestBuilder(nil).WithReplicas(3).WithPartition(1)
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, int32(3), *testBuilder.Definition.Spec.Replicas)
+	assert.Equal(t, appsv1.RollingUpdateStatefulSetStrategyType, testBuilder.Definition.Spec.UpdateStrategy.Type)
+	assert.Equal(t, int32(1), *testBuilder.Definition.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	testBuilder = buildValidStatefulSetTestBuilder(nil).WithReplicas(-1)
+	assert.Equal(t, "statefulset 'replicas' cannot be negative", testBuilder.errorMsg)
+
+	testBuilder = buildValidStatefulSetTestBuilder(nil).WithPartition(-1)
+	assert.Equal(t, "statefulset 'partition' cannot be negative", testBuilder.errorMsg)
+}
+
+func TestStatefulSetUpdate(t *testing.T) {
+	testBuilder := buildValidStatefulSetTestBuilder([]runtime.Object{buildTestStatefulSet(1)})
+	assert.True(t, testBuilder.Exists())
+
+	testBuilder.Definition = testBuilder.Object
+	testBuilder.Definition.Spec.Template.Spec.Containers[0].Image = "test-image:v2"
+
+	testBuilder, err := testBuilder.Update()
+	assert.Nil(t, err)
+	assert.Equal(t, "test-image:v2", testBuilder.Object.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestStatefulSetDelete(t *testing.T) {
+	testCases := []struct {
+		exists bool
+	}{
+		{exists: true},
+		{exists: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildTestStatefulSet(1))
+		}
+
+		testBuilder := buildValidStatefulSetTestBuilder(runtimeObjects)
+
+		err := testBuilder.DeleteAndWait(time.Second)
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+		assert.False(t, testBuilder.Exists())
+	}
+}
+
+func buildValidStatefulSetTestBuilder(objects []runtime.Object) *Builder {
+	return NewBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects}),
+		"test-statefulset", "test-namespace", map[string]string{"app": "test"},
+		&corev1.Container{Name: "test-container", Image: "test-image"})
+}
+
+func buildTestStatefulSet(replicas int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-statefulset",
+			Namespace:  "test-namespace",
+			UID:        "test-uid",
+			Generation: 1,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test-container", Image: "test-image"}},
+				},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 1,
+			Replicas:           replicas,
+			ReadyReplicas:      replicas,
+			UpdatedReplicas:    replicas,
+			CurrentRevision:    "test-statefulset-1",
+			UpdateRevision:     "test-statefulset-1",
+		},
+	}
+}